@@ -0,0 +1,88 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ActiveZoneMinutesValue minutes of each heart rate zone
+type ActiveZoneMinutesValue struct {
+	ActiveZoneMinutes        uint64 `json:"activeZoneMinutes"`
+	FatBurnActiveZoneMinutes uint64 `json:"fatBurnActiveZoneMinutes"`
+	CardioActiveZoneMinutes  uint64 `json:"cardioActiveZoneMinutes"`
+	PeakActiveZoneMinutes    uint64 `json:"peakActiveZoneMinutes"`
+}
+
+// ActiveZoneMinutesDay active zone minutes of a day
+type ActiveZoneMinutesDay struct {
+	DateTime string                  `json:"dateTime"`
+	Value    *ActiveZoneMinutesValue `json:"value"`
+}
+
+// ActiveZoneMinutesMinute active zone minutes of a minute
+type ActiveZoneMinutesMinute struct {
+	Minute string                  `json:"minute"`
+	Value  *ActiveZoneMinutesValue `json:"value"`
+}
+
+// ActiveZoneMinutesIntraday intraday active zone minutes of a day
+type ActiveZoneMinutesIntraday struct {
+	DateTime string                     `json:"dateTime"`
+	Minutes  []*ActiveZoneMinutesMinute `json:"minutes"`
+}
+
+type ActiveZoneMinutesTimeSeriesResponse struct {
+	ActiveZoneMinutes []*ActiveZoneMinutesDay `json:"activities-active-zone-minutes"`
+}
+
+type ActiveZoneMinutesIntradayResponse struct {
+	ActiveZoneMinutesIntraday []*ActiveZoneMinutesIntraday `json:"activities-active-zone-minutes-intraday"`
+}
+
+func (a *Activity) getActiveZoneMinutesTimeSeries(url string) (*ActiveZoneMinutesTimeSeriesResponse, error) {
+	responseByteArray, err := a.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &ActiveZoneMinutesTimeSeriesResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ActiveZoneMinutesTimeSeriesByID active zone minutes of period ending at date
+func (a *Activity) ActiveZoneMinutesTimeSeriesByID(userID string, date string, period Period) (*ActiveZoneMinutesTimeSeriesResponse, error) {
+	return a.getActiveZoneMinutesTimeSeries(fmt.Sprintf(ActivityTimeSeriesURL, userID, string(ActiveZoneMinutesLog), date, string(period)))
+}
+
+func (a *Activity) ActiveZoneMinutesTimeSeries(date string, period Period) (*ActiveZoneMinutesTimeSeriesResponse, error) {
+	return a.ActiveZoneMinutesTimeSeriesByID("-", date, period)
+}
+
+// ActiveZoneMinutesTimeSeriesByDateRangeByID active zone minutes between startDate and endDate
+func (a *Activity) ActiveZoneMinutesTimeSeriesByDateRangeByID(userID string, startDate string, endDate string) (*ActiveZoneMinutesTimeSeriesResponse, error) {
+	return a.getActiveZoneMinutesTimeSeries(fmt.Sprintf(ActivityTimeSeriesURL, userID, string(ActiveZoneMinutesLog), startDate, endDate))
+}
+
+func (a *Activity) ActiveZoneMinutesTimeSeriesByDateRange(startDate string, endDate string) (*ActiveZoneMinutesTimeSeriesResponse, error) {
+	return a.ActiveZoneMinutesTimeSeriesByDateRangeByID("-", startDate, endDate)
+}
+
+// ActiveZoneMinutesIntradayByID intraday active zone minutes. detailLevel is
+// OneMinute, FiveMinutes or FifteenMinutes. window nil means whole day
+func (a *Activity) ActiveZoneMinutesIntradayByID(userID string, date string, detailLevel DetailLevel, window *TimeWindow) (*ActiveZoneMinutesIntradayResponse, error) {
+	responseByteArray, err := a.c.Get(intradayURL(userID, string(ActiveZoneMinutesLog), date, detailLevel, window))
+	if err != nil {
+		return nil, err
+	}
+	response := &ActiveZoneMinutesIntradayResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (a *Activity) ActiveZoneMinutesIntraday(date string, detailLevel DetailLevel, window *TimeWindow) (*ActiveZoneMinutesIntradayResponse, error) {
+	return a.ActiveZoneMinutesIntradayByID("-", date, detailLevel, window)
+}
@@ -4,13 +4,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 type Period string
 type ActivityLogType string
 type ActivityGoalsPeriod string
+type DetailLevel string
+type CollectionType string
 
 const (
 	StepsLog               ActivityLogType = "activities/steps"
@@ -25,6 +28,28 @@ const (
 	MinutesVeryActiveLog   ActivityLogType = "activities/minutesVeryActive"
 	ActivityCaloriesLog    ActivityLogType = "activities/activityCalories"
 
+	TrackerStepsLog               ActivityLogType = "activities/tracker/steps"
+	TrackerCaloriesLog            ActivityLogType = "activities/tracker/calories"
+	TrackerDistanceLog            ActivityLogType = "activities/tracker/distance"
+	TrackerFloorsLog              ActivityLogType = "activities/tracker/floors"
+	TrackerElevationLog           ActivityLogType = "activities/tracker/elevation"
+	TrackerMinutesSedentaryLog    ActivityLogType = "activities/tracker/minutesSedentary"
+	TrackerMinutesLightActiveLog  ActivityLogType = "activities/tracker/minutesLightlyActive"
+	TrackerMinutesFairlyActiveLog ActivityLogType = "activities/tracker/minutesFairlyActive"
+	TrackerMinutesVeryActiveLog   ActivityLogType = "activities/tracker/minutesVeryActive"
+	TrackerActivityCaloriesLog    ActivityLogType = "activities/tracker/activityCalories"
+
+	// ActiveZoneMinutesLog values aren't string. use ActiveZoneMinutesTimeSeries
+	ActiveZoneMinutesLog ActivityLogType = "activities/active-zone-minutes"
+
+	ActivitiesCollection        CollectionType = "activities"
+	BodyCollection              CollectionType = "body"
+	FoodsCollection             CollectionType = "foods"
+	SleepCollection             CollectionType = "sleep"
+	UserRevokedAccessCollection CollectionType = "userRevokedAccess"
+	// AllCollections subscribe to all collections
+	AllCollections CollectionType = ""
+
 	OneMonth   Period = "1m"
 	OneDay     Period = "1d"
 	OneWeek    Period = "7d"
@@ -34,19 +59,28 @@ const (
 	OneYear    Period = "1y"
 	Max        Period = "max"
 
+	OneSecond      DetailLevel = "1sec"
+	OneMinute      DetailLevel = "1min"
+	FiveMinutes    DetailLevel = "5min"
+	FifteenMinutes DetailLevel = "15min"
+
 	ActivityGoalsDaily  ActivityGoalsPeriod = "daily"
 	ActivityGoalsWeekly ActivityGoalsPeriod = "weekly"
 	// ActivityURL fitbit activity api url
 	ActivityURL                 string = "https://api.fitbit.com/1/user/%s/activities/date/%s.json"
 	ActivityTimeSeriesURL       string = "https://api.fitbit.com/1/user/%s/%s/date/%s/%s.json"
+	ActivityIntradayURL         string = "https://api.fitbit.com/1/user/%s/%s/date/%s/1d/%s.json"
+	ActivityIntradayTimeURL     string = "https://api.fitbit.com/1/user/%s/%s/date/%s/1d/%s/time/%s/%s.json"
 	BrowseActivityTypesURL      string = "https://api.fitbit.com/1/activities.json"
 	GetActivityTypeURL          string = "https://api.fitbit.com/1/activities/%s.json"
 	GetFrequentActivitiesURL    string = "https://api.fitbit.com/1/user/-/activities/frequent.json"
 	GetRecentActivitiesURL      string = "https://api.fitbit.com/1/user/-/activities/recent.json"
 	GetFavoriteActivitiesURL    string = "https://api.fitbit.com/1/user/%s/activities/favorite.json"
 	FavoriteActivityResourceURL string = "https://api.fitbit.com/1/user/-/activities/favorite/%s.json"
+	UserFavoriteActivityURL     string = "https://api.fitbit.com/1/user/%s/activities/favorite/%s.json"
 	ActivityGoalsURL            string = "https://api.fitbit.com/1/user/%s/activities/goals/%s.json"
 	LifeTimeStatsURL            string = "https://api.fitbit.com/1/user/%s/activities.json"
+	ActivityTCXURL              string = "https://api.fitbit.com/1/user/%s/activities/%d.tcx"
 )
 
 // Activities
@@ -54,28 +88,45 @@ type Activity struct {
 	c *Client
 }
 
+// ActivityLogSource source of activity log
+type ActivityLogSource struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	TrackerFeatures []string `json:"trackerFeatures"`
+	Type            string   `json:"type"`
+	URL             string   `json:"url"`
+}
+
 // Activity fitbit activity data
 type ActivityData struct {
-	ActivityID       uint64  `json:"activityId"`
-	ActivityParentID uint64  `json:"activityParentId"`
-	Calories         uint64  `json:"calories"`
-	Description      string  `json:"description"`
-	Distance         float64 `json:"distance"`
-	Duration         uint64  `json:"duration"`
-	HasStartTime     bool    `json:"hasStartTime"`
-	IsFavorite       bool    `json:"isFavorite"`
-	LogID            uint64  `json:"logId"`
-	Name             string  `json:"name"`
-	StartTime        string  `json:"startTime"`
-	Steps            uint64  `json:"steps"`
+	ActivityID           uint64             `json:"activityId"`
+	ActivityParentID     uint64             `json:"activityParentId"`
+	ActivityParentName   string             `json:"activityParentName"`
+	Calories             uint64             `json:"calories"`
+	Description          string             `json:"description"`
+	Distance             float64            `json:"distance"`
+	Duration             uint64             `json:"duration"`
+	HasActiveZoneMinutes bool               `json:"hasActiveZoneMinutes"`
+	HasStartTime         bool               `json:"hasStartTime"`
+	IsFavorite           bool               `json:"isFavorite"`
+	LastModified         string             `json:"lastModified"`
+	LogID                uint64             `json:"logId"`
+	LogType              string             `json:"logType"`
+	Name                 string             `json:"name"`
+	Source               *ActivityLogSource `json:"source"`
+	StartDate            string             `json:"startDate"`
+	StartTime            string             `json:"startTime"`
+	Steps                uint64             `json:"steps"`
 }
 
 // Goals fitbit Goals
 type Goals struct {
-	CaloriesOut uint64  `json:"caloriesOut"`
-	Distance    float64 `json:"distance"`
-	Floors      uint64  `json:"floors"`
-	Steps       uint64  `json:"steps"`
+	ActiveMinutes     uint64  `json:"activeMinutes"`
+	ActiveZoneMinutes uint64  `json:"activeZoneMinutes"`
+	CaloriesOut       uint64  `json:"caloriesOut"`
+	Distance          float64 `json:"distance"`
+	Floors            uint64  `json:"floors"`
+	Steps             uint64  `json:"steps"`
 }
 
 // Distance fitbit Distance
@@ -84,20 +135,51 @@ type Distance struct {
 	Distance float64 `json:"distance"`
 }
 
+// HeartRateZone fitbit heart rate zone
+type HeartRateZone struct {
+	CaloriesOut float64 `json:"caloriesOut"`
+	Max         uint64  `json:"max"`
+	Min         uint64  `json:"min"`
+	Minutes     uint64  `json:"minutes"`
+	Name        string  `json:"name"`
+}
+
+// ActiveZoneMinutesInHeartRateZone active zone minutes of a heart rate zone
+type ActiveZoneMinutesInHeartRateZone struct {
+	MinuteMultiplier uint64 `json:"minuteMultiplier"`
+	Minutes          uint64 `json:"minutes"`
+	Order            uint64 `json:"order"`
+	Type             string `json:"type"`
+	ZoneName         string `json:"zoneName"`
+}
+
+// SummaryActiveZoneMinutes active zone minutes of daily summary
+type SummaryActiveZoneMinutes struct {
+	MinutesInHeartRateZones []*ActiveZoneMinutesInHeartRateZone `json:"minutesInHeartRateZones"`
+	TotalMinutes            uint64                              `json:"totalMinutes"`
+}
+
 // Summary fitbit activity summary
 type Summary struct {
-	ActivityCalories     uint64      `json:"activityCalories"`
-	CaloriesBMR          uint64      `json:"caloriesBMR"`
-	CaloriesOut          uint64      `json:"caloriesOut"`
-	Distances            []*Distance `json:"distances"`
-	Elevation            float64     `json:"elevation"`
-	FairlyActiveMinutes  uint64      `json:"fairlyActiveMinutes"`
-	Floors               uint64      `json:"floors"`
-	LightlyActiveMinutes uint64      `json:"lightlyActiveMinutes"`
-	MarginalCalories     uint64      `json:"marginalCalories"`
-	SedentaryMinutes     uint64      `json:"sedentaryMinutes"`
-	Steps                uint64      `json:"steps"`
-	VeryActiveMinutes    uint64      `json:"veryActiveMinutes"`
+	ActiveScore            int64                     `json:"activeScore"`
+	ActiveZoneMinutes      *SummaryActiveZoneMinutes `json:"activeZoneMinutes"`
+	ActivityCalories       uint64                    `json:"activityCalories"`
+	CaloriesBMR            uint64                    `json:"caloriesBMR"`
+	CaloriesEstimationMu   uint64                    `json:"caloriesEstimationMu"`
+	CaloriesOut            uint64                    `json:"caloriesOut"`
+	CaloriesOutUnestimated uint64                    `json:"caloriesOutUnestimated"`
+	Distances              []*Distance               `json:"distances"`
+	Elevation              float64                   `json:"elevation"`
+	FairlyActiveMinutes    uint64                    `json:"fairlyActiveMinutes"`
+	Floors                 uint64                    `json:"floors"`
+	HeartRateZones         []*HeartRateZone          `json:"heartRateZones"`
+	LightlyActiveMinutes   uint64                    `json:"lightlyActiveMinutes"`
+	MarginalCalories       uint64                    `json:"marginalCalories"`
+	RestingHeartRate       uint64                    `json:"restingHeartRate"`
+	SedentaryMinutes       uint64                    `json:"sedentaryMinutes"`
+	Steps                  uint64                    `json:"steps"`
+	UseEstimation          bool                      `json:"useEstimation"`
+	VeryActiveMinutes      uint64                    `json:"veryActiveMinutes"`
 }
 
 // ActivityResponse fitbit activity api response
@@ -105,6 +187,18 @@ type ActivityResponse struct {
 	Activities []*ActivityData `json:"activities"`
 	Goals      Goals           `json:"goals"`
 	Summary    Summary         `json:"summary"`
+	// Raw response body. fields the library doesn't know yet can be read from it
+	Raw json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decode response and keep raw body
+func (r *ActivityResponse) UnmarshalJSON(data []byte) error {
+	type activityResponse ActivityResponse
+	if err := json.Unmarshal(data, (*activityResponse)(r)); err != nil {
+		return err
+	}
+	r.Raw = append(json.RawMessage(nil), data...)
+	return nil
 }
 
 type ActivitiesLog struct {
@@ -127,6 +221,27 @@ type ActivityTimeSeriesResponse struct {
 	Intraday *ActivitiesLogIntraday
 }
 
+// ActivityIntradayDataSet intraday value at Time. Level and Mets are returned
+// only for calories
+type ActivityIntradayDataSet struct {
+	Time  string  `json:"time"`
+	Value float64 `json:"value"`
+	Level uint64  `json:"level"`
+	Mets  float64 `json:"mets"`
+}
+
+// ActivityIntradaySeries intraday values of a day
+type ActivityIntradaySeries struct {
+	DataSet         []*ActivityIntradayDataSet `json:"dataset"`
+	DataSetInterval uint64                     `json:"datasetInterval"`
+	DataSetType     string                     `json:"datasetType"`
+}
+
+type ActivityIntradayResponse struct {
+	Logs     []*ActivitiesLog
+	Intraday *ActivityIntradaySeries
+}
+
 type ActivitiesLogStepsResponse struct {
 	ActivitiesLogSteps         []*ActivitiesLog       `json:"activities-steps"`
 	ActivitiesLogStepsIntraday *ActivitiesLogIntraday `json:"activities-steps-intraday"`
@@ -176,16 +291,49 @@ type ActivitiesLogActivityCaloriesResponse struct {
 	ActivitiesLogActivityCalories []*ActivitiesLog `json:"activities-activityCalories"`
 }
 
+type ActivitiesLogTrackerStepsResponse struct {
+	ActivitiesLogTrackerSteps []*ActivitiesLog `json:"activities-tracker-steps"`
+}
+
+type ActivitiesLogTrackerCaloriesResponse struct {
+	ActivitiesLogTrackerCalories []*ActivitiesLog `json:"activities-tracker-calories"`
+}
+
+type ActivitiesLogTrackerDistanceResponse struct {
+	ActivitiesLogTrackerDistance []*ActivitiesLog `json:"activities-tracker-distance"`
+}
+
+type ActivitiesLogTrackerFloorsResponse struct {
+	ActivitiesLogTrackerFloors []*ActivitiesLog `json:"activities-tracker-floors"`
+}
+
+type ActivitiesLogTrackerElevationResponse struct {
+	ActivitiesLogTrackerElevation []*ActivitiesLog `json:"activities-tracker-elevation"`
+}
+
+type ActivitiesLogTrackerMinutesSedentaryResponse struct {
+	ActivitiesLogTrackerMinutesSedentary []*ActivitiesLog `json:"activities-tracker-minutesSedentary"`
+}
+
+type ActivitiesLogTrackerMinutesLightActiveResponse struct {
+	ActivitiesLogTrackerMinutesLightActive []*ActivitiesLog `json:"activities-tracker-minutesLightlyActive"`
+}
+
+type ActivitiesLogTrackerMinutesFairlyActiveResponse struct {
+	ActivitiesLogTrackerMinutesFairlyActive []*ActivitiesLog `json:"activities-tracker-minutesFairlyActive"`
+}
+
+type ActivitiesLogTrackerMinutesVeryActiveResponse struct {
+	ActivitiesLogTrackerMinutesVeryActive []*ActivitiesLog `json:"activities-tracker-minutesVeryActive"`
+}
+
+type ActivitiesLogTrackerActivityCaloriesResponse struct {
+	ActivitiesLogTrackerActivityCalories []*ActivitiesLog `json:"activities-tracker-activityCalories"`
+}
+
 // DailyActivitySummaryByID hogehoge
 func (a *Activity) DailyActivitySummaryByID(userID string, date string) (*ActivityResponse, error) {
-	url := fmt.Sprintf(ActivityURL, userID, date)
-	result, err := a.c.httpClient.Get(url)
-	if err != nil {
-		return nil, err
-	}
-
-	defer result.Body.Close()
-	resultByteArray, err := ioutil.ReadAll(result.Body)
+	resultByteArray, err := a.c.Get(fmt.Sprintf(ActivityURL, userID, date))
 	if err != nil {
 		return nil, err
 	}
@@ -217,6 +365,67 @@ func (a *Activity) ActivityTimeSeries(date string, period Period, activityLogTyp
 	return a.ActivityTimeSeriesByID("-", date, period, activityLogType)
 }
 
+// ActivityTimeSeriesByDateRangeByID time series between startDate and endDate
+func (a *Activity) ActivityTimeSeriesByDateRangeByID(userID string, startDate string, endDate string, activityLogType ActivityLogType) (*ActivityTimeSeriesResponse, error) {
+	resultByteArray, err := a.c.Get(fmt.Sprintf(ActivityTimeSeriesURL, userID, string(activityLogType), startDate, endDate))
+	if err != nil {
+		return nil, err
+	}
+	return activityLogConvert(resultByteArray, activityLogType)
+}
+
+func (a *Activity) ActivityTimeSeriesByDateRange(startDate string, endDate string, activityLogType ActivityLogType) (*ActivityTimeSeriesResponse, error) {
+	return a.ActivityTimeSeriesByDateRangeByID("-", startDate, endDate, activityLogType)
+}
+
+// TimeWindow time range of intraday request. "HH:mm" format
+type TimeWindow struct {
+	StartTime string
+	EndTime   string
+}
+
+func intradayURL(userID string, resource string, date string, detailLevel DetailLevel, window *TimeWindow) string {
+	if window == nil {
+		return fmt.Sprintf(ActivityIntradayURL, userID, resource, date, string(detailLevel))
+	}
+	return fmt.Sprintf(ActivityIntradayTimeURL, userID, resource, date, string(detailLevel), window.StartTime, window.EndTime)
+}
+
+// ActivityIntradayByID intraday time series of date. window nil means whole day
+func (a *Activity) ActivityIntradayByID(userID string, date string, detailLevel DetailLevel, activityLogType ActivityLogType, window *TimeWindow) (*ActivityIntradayResponse, error) {
+	resultByteArray, err := a.c.Get(intradayURL(userID, string(activityLogType), date, detailLevel, window))
+	if err != nil {
+		return nil, err
+	}
+	return activityIntradayConvert(resultByteArray, activityLogType)
+}
+
+func (a *Activity) ActivityIntraday(date string, detailLevel DetailLevel, activityLogType ActivityLogType, window *TimeWindow) (*ActivityIntradayResponse, error) {
+	return a.ActivityIntradayByID("-", date, detailLevel, activityLogType, window)
+}
+
+// activityIntradayConvert decode response keyed by resource. "activities/steps"
+// is returned as "activities-steps" and "activities-steps-intraday"
+func activityIntradayConvert(resultByteArray []byte, activityLogType ActivityLogType) (*ActivityIntradayResponse, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(resultByteArray, &fields); err != nil {
+		return nil, err
+	}
+	key := strings.Replace(string(activityLogType), "/", "-", -1)
+	response := &ActivityIntradayResponse{}
+	if raw, ok := fields[key]; ok {
+		if err := json.Unmarshal(raw, &response.Logs); err != nil {
+			return nil, err
+		}
+	}
+	if raw, ok := fields[key+"-intraday"]; ok {
+		if err := json.Unmarshal(raw, &response.Intraday); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
 func activityLogConvert(resultByteArray []byte, activityLogType ActivityLogType) (*ActivityTimeSeriesResponse, error) {
 	switch activityLogType {
 	case StepsLog:
@@ -285,6 +494,68 @@ func activityLogConvert(resultByteArray []byte, activityLogType ActivityLogType)
 			return nil, err
 		}
 		return &ActivityTimeSeriesResponse{Logs: activityLogActivityCalories.ActivitiesLogActivityCalories}, nil
+	case TrackerStepsLog:
+		activityLogTrackerSteps := &ActivitiesLogTrackerStepsResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerSteps); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerSteps.ActivitiesLogTrackerSteps}, nil
+	case TrackerCaloriesLog:
+		activityLogTrackerCalories := &ActivitiesLogTrackerCaloriesResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerCalories); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerCalories.ActivitiesLogTrackerCalories}, nil
+	case TrackerDistanceLog:
+		activityLogTrackerDistance := &ActivitiesLogTrackerDistanceResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerDistance); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerDistance.ActivitiesLogTrackerDistance}, nil
+	case TrackerFloorsLog:
+		activityLogTrackerFloors := &ActivitiesLogTrackerFloorsResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerFloors); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerFloors.ActivitiesLogTrackerFloors}, nil
+	case TrackerElevationLog:
+		activityLogTrackerElevation := &ActivitiesLogTrackerElevationResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerElevation); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerElevation.ActivitiesLogTrackerElevation}, nil
+	case TrackerMinutesSedentaryLog:
+		activityLogTrackerMinutesSedentary := &ActivitiesLogTrackerMinutesSedentaryResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerMinutesSedentary); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerMinutesSedentary.ActivitiesLogTrackerMinutesSedentary}, nil
+	case TrackerMinutesLightActiveLog:
+		activityLogTrackerMinutesLightActive := &ActivitiesLogTrackerMinutesLightActiveResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerMinutesLightActive); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerMinutesLightActive.ActivitiesLogTrackerMinutesLightActive}, nil
+	case TrackerMinutesFairlyActiveLog:
+		activityLogTrackerMinutesFairlyActive := &ActivitiesLogTrackerMinutesFairlyActiveResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerMinutesFairlyActive); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerMinutesFairlyActive.ActivitiesLogTrackerMinutesFairlyActive}, nil
+	case TrackerMinutesVeryActiveLog:
+		activityLogTrackerMinutesVeryActive := &ActivitiesLogTrackerMinutesVeryActiveResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerMinutesVeryActive); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerMinutesVeryActive.ActivitiesLogTrackerMinutesVeryActive}, nil
+	case TrackerActivityCaloriesLog:
+		activityLogTrackerActivityCalories := &ActivitiesLogTrackerActivityCaloriesResponse{}
+		if err := json.Unmarshal(resultByteArray, activityLogTrackerActivityCalories); err != nil {
+			return nil, err
+		}
+		return &ActivityTimeSeriesResponse{Logs: activityLogTrackerActivityCalories.ActivitiesLogTrackerActivityCalories}, nil
+	case ActiveZoneMinutesLog:
+		return nil, errors.New(string(activityLogType) + " isn't string value. use ActiveZoneMinutesTimeSeries")
 	default:
 		return nil, errors.New(string(activityLogType) + " not implemented")
 	}
@@ -304,11 +575,15 @@ type ActivityType struct {
 	ActivityLevels []*ActivityLevel `json:"activityLevels"`
 	HasSpeed       bool             `json:"hasSpeed"`
 	ID             uint64           `json:"id"`
+	Mets           float64          `json:"mets"`
 	Name           string           `json:"name"`
 }
 
 type Category struct {
-	Activities []*ActivityType `json:"activities"`
+	Activities    []*ActivityType `json:"activities"`
+	ID            uint64          `json:"id"`
+	Name          string          `json:"name"`
+	SubCategories []*Category     `json:"subCategories"`
 }
 
 type BrowseActivityTypesResponse struct {
@@ -420,11 +695,64 @@ func (a *Activity) DeleteFavoriteActivity(activityID string) error {
 	return nil
 }
 
+func (a *Activity) AddFavoriteActivityByID(userID string, activityID string) error {
+	return a.c.Post(fmt.Sprintf(UserFavoriteActivityURL, userID, activityID))
+}
+
+func (a *Activity) DeleteFavoriteActivityByID(userID string, activityID string) error {
+	return a.c.Delete(fmt.Sprintf(UserFavoriteActivityURL, userID, activityID))
+}
+
 type ActivityGoal struct {
-	CaloriesOut uint64  `json:"caloriesOut"`
-	Distance    float64 `json:"distance"`
-	Floors      uint64  `json:"floors"`
-	Steps       uint64  `json:"steps"`
+	ActiveMinutes     uint64  `json:"activeMinutes"`
+	ActiveZoneMinutes uint64  `json:"activeZoneMinutes"`
+	CaloriesOut       uint64  `json:"caloriesOut"`
+	Distance          float64 `json:"distance"`
+	Floors            uint64  `json:"floors"`
+	Steps             uint64  `json:"steps"`
+}
+
+// ActivityGoalParams update parameters of activity goals. nil field isn't sent
+type ActivityGoalParams struct {
+	ActiveMinutes     *uint64
+	ActiveZoneMinutes *uint64
+	CaloriesOut       *uint64
+	Distance          *float64
+	Floors            *uint64
+	Steps             *uint64
+}
+
+// Uint64 return pointer of v
+func Uint64(v uint64) *uint64 {
+	return &v
+}
+
+// Float64 return pointer of v
+func Float64(v float64) *float64 {
+	return &v
+}
+
+func (p *ActivityGoalParams) values() url.Values {
+	values := url.Values{}
+	if p.ActiveMinutes != nil {
+		values.Add("activeMinutes", strconv.FormatUint(*p.ActiveMinutes, 10))
+	}
+	if p.ActiveZoneMinutes != nil {
+		values.Add("activeZoneMinutes", strconv.FormatUint(*p.ActiveZoneMinutes, 10))
+	}
+	if p.CaloriesOut != nil {
+		values.Add("caloriesOut", strconv.FormatUint(*p.CaloriesOut, 10))
+	}
+	if p.Distance != nil {
+		values.Add("distance", strconv.FormatFloat(*p.Distance, 'f', -1, 64))
+	}
+	if p.Floors != nil {
+		values.Add("floors", strconv.FormatUint(*p.Floors, 10))
+	}
+	if p.Steps != nil {
+		values.Add("steps", strconv.FormatUint(*p.Steps, 10))
+	}
+	return values
 }
 
 type ActivityGoalsResponse struct {
@@ -448,19 +776,16 @@ func (a *Activity) GetActivityGoals(period ActivityGoalsPeriod) (*ActivityGoalsR
 	return a.GetActivityGoalsByID("-", period)
 }
 
-func (a *Activity) UpdateActivityGoalsByID(userID string, period ActivityGoalsPeriod, params *ActivityGoal) (*ActivityGoalsResponse, error) {
-	targetUrl := fmt.Sprintf(ActivityGoalsURL, userID, string(period))
-	values := url.Values{}
-	values.Add("caloriesOut", string(params.CaloriesOut))
-	values.Add("distance", fmt.Sprintf("%6.2f", params.Distance))
-	values.Add("floors", string(params.Floors))
-	values.Add("steps", string(params.Steps))
-	response, err := a.c.httpClient.PostForm(targetUrl, values)
-	if err != nil {
-		return nil, err
+// UpdateActivityGoalsByID update only the goals set in params
+func (a *Activity) UpdateActivityGoalsByID(userID string, period ActivityGoalsPeriod, params *ActivityGoalParams) (*ActivityGoalsResponse, error) {
+	if params == nil {
+		return nil, errors.New("paramsがnilです")
+	}
+	values := params.values()
+	if len(values) == 0 {
+		return nil, errors.New("no goal to update")
 	}
-	defer response.Body.Close()
-	responseByteArray, err := ioutil.ReadAll(response.Body)
+	responseByteArray, err := a.c.PostForm(fmt.Sprintf(ActivityGoalsURL, userID, string(period)), values)
 	if err != nil {
 		return nil, err
 	}
@@ -472,7 +797,7 @@ func (a *Activity) UpdateActivityGoalsByID(userID string, period ActivityGoalsPe
 	return ret, nil
 }
 
-func (a *Activity) UpdateActivityGoals(period ActivityGoalsPeriod, params *ActivityGoal) (*ActivityGoalsResponse, error) {
+func (a *Activity) UpdateActivityGoals(period ActivityGoalsPeriod, params *ActivityGoalParams) (*ActivityGoalsResponse, error) {
 	return a.UpdateActivityGoalsByID("-", period, params)
 }
 
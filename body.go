@@ -0,0 +1,328 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type BodyTimeSeriesType string
+
+const (
+	BodyWeightSeries BodyTimeSeriesType = "body/weight"
+	BodyFatSeries    BodyTimeSeriesType = "body/fat"
+	BodyBMISeries    BodyTimeSeriesType = "body/bmi"
+
+	WeightLogURL      string = "https://api.fitbit.com/1/user/%s/body/log/weight/date/%s.json"
+	WeightLogRangeURL string = "https://api.fitbit.com/1/user/%s/body/log/weight/date/%s/%s.json"
+	CreateWeightURL   string = "https://api.fitbit.com/1/user/-/body/log/weight.json"
+	DeleteWeightURL   string = "https://api.fitbit.com/1/user/-/body/log/weight/%d.json"
+	FatLogURL         string = "https://api.fitbit.com/1/user/%s/body/log/fat/date/%s.json"
+	FatLogRangeURL    string = "https://api.fitbit.com/1/user/%s/body/log/fat/date/%s/%s.json"
+	CreateFatURL      string = "https://api.fitbit.com/1/user/-/body/log/fat.json"
+	DeleteFatURL      string = "https://api.fitbit.com/1/user/-/body/log/fat/%d.json"
+	WeightGoalURL     string = "https://api.fitbit.com/1/user/%s/body/log/weight/goal.json"
+	FatGoalURL        string = "https://api.fitbit.com/1/user/%s/body/log/fat/goal.json"
+)
+
+// Body fitbit body api. weight is returned in the unit system set by
+// Client.SetUnitSystem
+type Body struct {
+	c *Client
+}
+
+// WeightLog fitbit weight log
+type WeightLog struct {
+	BMI    float64 `json:"bmi"`
+	Date   string  `json:"date"`
+	Fat    float64 `json:"fat"`
+	LogID  uint64  `json:"logId"`
+	Source string  `json:"source"`
+	Time   string  `json:"time"`
+	Weight float64 `json:"weight"`
+}
+
+// FatLog fitbit body fat log
+type FatLog struct {
+	Date   string  `json:"date"`
+	Fat    float64 `json:"fat"`
+	LogID  uint64  `json:"logId"`
+	Source string  `json:"source"`
+	Time   string  `json:"time"`
+}
+
+type WeightLogResponse struct {
+	Weight []*WeightLog `json:"weight"`
+}
+
+type CreateWeightLogResponse struct {
+	WeightLog *WeightLog `json:"weightLog"`
+}
+
+type FatLogResponse struct {
+	Fat []*FatLog `json:"fat"`
+}
+
+type CreateFatLogResponse struct {
+	FatLog *FatLog `json:"fatLog"`
+}
+
+type BodyWeightTimeSeriesResponse struct {
+	BodyWeight []*ActivitiesLog `json:"body-weight"`
+}
+
+type BodyFatTimeSeriesResponse struct {
+	BodyFat []*ActivitiesLog `json:"body-fat"`
+}
+
+type BodyBMITimeSeriesResponse struct {
+	BodyBMI []*ActivitiesLog `json:"body-bmi"`
+}
+
+// WeightGoal fitbit weight goal
+type WeightGoal struct {
+	GoalType        string  `json:"goalType"`
+	StartDate       string  `json:"startDate"`
+	StartWeight     float64 `json:"startWeight"`
+	Weight          float64 `json:"weight"`
+	WeightThreshold float64 `json:"weightThreshold"`
+}
+
+type WeightGoalResponse struct {
+	Goal *WeightGoal `json:"goal"`
+}
+
+// FatGoal fitbit body fat goal
+type FatGoal struct {
+	Fat float64 `json:"fat"`
+}
+
+type FatGoalResponse struct {
+	Goal *FatGoal `json:"goal"`
+}
+
+func (b *Body) getWeightLog(url string) (*WeightLogResponse, error) {
+	responseByteArray, err := b.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &WeightLogResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// WeightLogByID weight logs of date
+func (b *Body) WeightLogByID(userID string, date string) (*WeightLogResponse, error) {
+	return b.getWeightLog(fmt.Sprintf(WeightLogURL, userID, date))
+}
+
+func (b *Body) WeightLog(date string) (*WeightLogResponse, error) {
+	return b.WeightLogByID("-", date)
+}
+
+// WeightLogByDateRangeByID weight logs between startDate and endDate
+func (b *Body) WeightLogByDateRangeByID(userID string, startDate string, endDate string) (*WeightLogResponse, error) {
+	return b.getWeightLog(fmt.Sprintf(WeightLogRangeURL, userID, startDate, endDate))
+}
+
+func (b *Body) WeightLogByDateRange(startDate string, endDate string) (*WeightLogResponse, error) {
+	return b.WeightLogByDateRangeByID("-", startDate, endDate)
+}
+
+func bodyLogValues(key string, value float64, dateTime time.Time) url.Values {
+	values := url.Values{}
+	values.Add(key, strconv.FormatFloat(value, 'f', -1, 64))
+	values.Add("date", dateTime.Format("2006-01-02"))
+	values.Add("time", dateTime.Format("15:04:05"))
+	return values
+}
+
+// CreateWeightLog log weight at dateTime
+func (b *Body) CreateWeightLog(weight float64, dateTime time.Time) (*CreateWeightLogResponse, error) {
+	responseByteArray, err := b.c.PostForm(CreateWeightURL, bodyLogValues("weight", weight, dateTime))
+	if err != nil {
+		return nil, err
+	}
+	response := &CreateWeightLogResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DeleteWeightLog delete weight log
+func (b *Body) DeleteWeightLog(logID uint64) error {
+	return b.c.Delete(fmt.Sprintf(DeleteWeightURL, logID))
+}
+
+func (b *Body) getFatLog(url string) (*FatLogResponse, error) {
+	responseByteArray, err := b.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &FatLogResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// FatLogByID body fat logs of date
+func (b *Body) FatLogByID(userID string, date string) (*FatLogResponse, error) {
+	return b.getFatLog(fmt.Sprintf(FatLogURL, userID, date))
+}
+
+func (b *Body) FatLog(date string) (*FatLogResponse, error) {
+	return b.FatLogByID("-", date)
+}
+
+// FatLogByDateRangeByID body fat logs between startDate and endDate
+func (b *Body) FatLogByDateRangeByID(userID string, startDate string, endDate string) (*FatLogResponse, error) {
+	return b.getFatLog(fmt.Sprintf(FatLogRangeURL, userID, startDate, endDate))
+}
+
+func (b *Body) FatLogByDateRange(startDate string, endDate string) (*FatLogResponse, error) {
+	return b.FatLogByDateRangeByID("-", startDate, endDate)
+}
+
+// CreateFatLog log body fat percentage at dateTime
+func (b *Body) CreateFatLog(fat float64, dateTime time.Time) (*CreateFatLogResponse, error) {
+	responseByteArray, err := b.c.PostForm(CreateFatURL, bodyLogValues("fat", fat, dateTime))
+	if err != nil {
+		return nil, err
+	}
+	response := &CreateFatLogResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DeleteFatLog delete body fat log
+func (b *Body) DeleteFatLog(logID uint64) error {
+	return b.c.Delete(fmt.Sprintf(DeleteFatURL, logID))
+}
+
+// TimeSeriesByID body time series of period ending at date
+func (b *Body) TimeSeriesByID(userID string, date string, period Period, timeSeriesType BodyTimeSeriesType) ([]*ActivitiesLog, error) {
+	responseByteArray, err := b.c.Get(fmt.Sprintf(ActivityTimeSeriesURL, userID, string(timeSeriesType), date, string(period)))
+	if err != nil {
+		return nil, err
+	}
+	return bodyTimeSeriesConvert(responseByteArray, timeSeriesType)
+}
+
+func (b *Body) TimeSeries(date string, period Period, timeSeriesType BodyTimeSeriesType) ([]*ActivitiesLog, error) {
+	return b.TimeSeriesByID("-", date, period, timeSeriesType)
+}
+
+// TimeSeriesByDateRangeByID body time series between startDate and endDate
+func (b *Body) TimeSeriesByDateRangeByID(userID string, startDate string, endDate string, timeSeriesType BodyTimeSeriesType) ([]*ActivitiesLog, error) {
+	responseByteArray, err := b.c.Get(fmt.Sprintf(ActivityTimeSeriesURL, userID, string(timeSeriesType), startDate, endDate))
+	if err != nil {
+		return nil, err
+	}
+	return bodyTimeSeriesConvert(responseByteArray, timeSeriesType)
+}
+
+func (b *Body) TimeSeriesByDateRange(startDate string, endDate string, timeSeriesType BodyTimeSeriesType) ([]*ActivitiesLog, error) {
+	return b.TimeSeriesByDateRangeByID("-", startDate, endDate, timeSeriesType)
+}
+
+func bodyTimeSeriesConvert(responseByteArray []byte, timeSeriesType BodyTimeSeriesType) ([]*ActivitiesLog, error) {
+	switch timeSeriesType {
+	case BodyWeightSeries:
+		response := &BodyWeightTimeSeriesResponse{}
+		if err := json.Unmarshal(responseByteArray, response); err != nil {
+			return nil, err
+		}
+		return response.BodyWeight, nil
+	case BodyFatSeries:
+		response := &BodyFatTimeSeriesResponse{}
+		if err := json.Unmarshal(responseByteArray, response); err != nil {
+			return nil, err
+		}
+		return response.BodyFat, nil
+	case BodyBMISeries:
+		response := &BodyBMITimeSeriesResponse{}
+		if err := json.Unmarshal(responseByteArray, response); err != nil {
+			return nil, err
+		}
+		return response.BodyBMI, nil
+	default:
+		return nil, errors.New(string(timeSeriesType) + " not implemented")
+	}
+}
+
+// GetWeightGoalByID weight goal
+func (b *Body) GetWeightGoalByID(userID string) (*WeightGoalResponse, error) {
+	responseByteArray, err := b.c.Get(fmt.Sprintf(WeightGoalURL, userID))
+	if err != nil {
+		return nil, err
+	}
+	response := &WeightGoalResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (b *Body) GetWeightGoal() (*WeightGoalResponse, error) {
+	return b.GetWeightGoalByID("-")
+}
+
+// UpdateWeightGoal update weight goal. weight 0 keeps current target weight
+func (b *Body) UpdateWeightGoal(startDate string, startWeight float64, weight float64) (*WeightGoalResponse, error) {
+	values := url.Values{}
+	values.Add("startDate", startDate)
+	values.Add("startWeight", strconv.FormatFloat(startWeight, 'f', -1, 64))
+	if weight > 0 {
+		values.Add("weight", strconv.FormatFloat(weight, 'f', -1, 64))
+	}
+	responseByteArray, err := b.c.PostForm(fmt.Sprintf(WeightGoalURL, "-"), values)
+	if err != nil {
+		return nil, err
+	}
+	response := &WeightGoalResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetFatGoalByID body fat goal
+func (b *Body) GetFatGoalByID(userID string) (*FatGoalResponse, error) {
+	responseByteArray, err := b.c.Get(fmt.Sprintf(FatGoalURL, userID))
+	if err != nil {
+		return nil, err
+	}
+	response := &FatGoalResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (b *Body) GetFatGoal() (*FatGoalResponse, error) {
+	return b.GetFatGoalByID("-")
+}
+
+// UpdateFatGoal update body fat percentage goal
+func (b *Body) UpdateFatGoal(fat float64) (*FatGoalResponse, error) {
+	values := url.Values{}
+	values.Add("fat", strconv.FormatFloat(fat, 'f', -1, 64))
+	responseByteArray, err := b.c.PostForm(fmt.Sprintf(FatGoalURL, "-"), values)
+	if err != nil {
+		return nil, err
+	}
+	response := &FatGoalResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,111 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	BreathingRateURL              string = "https://api.fitbit.com/1/user/%s/br/date/%s.json"
+	BreathingRateRangeURL         string = "https://api.fitbit.com/1/user/%s/br/date/%s/%s.json"
+	BreathingRateIntradayURL      string = "https://api.fitbit.com/1/user/%s/br/date/%s/all.json"
+	BreathingRateIntradayRangeURL string = "https://api.fitbit.com/1/user/%s/br/date/%s/%s/all.json"
+)
+
+// BreathingRate fitbit breathing rate api
+type BreathingRate struct {
+	c *Client
+}
+
+// BreathingRateValue breaths per minute
+type BreathingRateValue struct {
+	BreathingRate float64 `json:"breathingRate"`
+}
+
+// BreathingRateSummary breathing rate of a day
+type BreathingRateSummary struct {
+	DateTime string              `json:"dateTime"`
+	Value    *BreathingRateValue `json:"value"`
+}
+
+// BreathingRateBySleepStage breathing rate of each sleep stage
+type BreathingRateBySleepStage struct {
+	DeepSleepSummary  *BreathingRateValue `json:"deepSleepSummary"`
+	FullSleepSummary  *BreathingRateValue `json:"fullSleepSummary"`
+	LightSleepSummary *BreathingRateValue `json:"lightSleepSummary"`
+	REMSleepSummary   *BreathingRateValue `json:"remSleepSummary"`
+}
+
+// BreathingRateIntraday breathing rate by sleep stage of a day
+type BreathingRateIntraday struct {
+	DateTime string                     `json:"dateTime"`
+	Value    *BreathingRateBySleepStage `json:"value"`
+}
+
+type BreathingRateSummaryResponse struct {
+	BreathingRate []*BreathingRateSummary `json:"br"`
+}
+
+type BreathingRateIntradayResponse struct {
+	BreathingRate []*BreathingRateIntraday `json:"br"`
+}
+
+func (b *BreathingRate) getSummary(url string) (*BreathingRateSummaryResponse, error) {
+	responseByteArray, err := b.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &BreathingRateSummaryResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (b *BreathingRate) getIntraday(url string) (*BreathingRateIntradayResponse, error) {
+	responseByteArray, err := b.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &BreathingRateIntradayResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// SummaryByID breathing rate of date
+func (b *BreathingRate) SummaryByID(userID string, date string) (*BreathingRateSummaryResponse, error) {
+	return b.getSummary(fmt.Sprintf(BreathingRateURL, userID, date))
+}
+
+func (b *BreathingRate) Summary(date string) (*BreathingRateSummaryResponse, error) {
+	return b.SummaryByID("-", date)
+}
+
+// SummaryByDateRangeByID breathing rate between startDate and endDate
+func (b *BreathingRate) SummaryByDateRangeByID(userID string, startDate string, endDate string) (*BreathingRateSummaryResponse, error) {
+	return b.getSummary(fmt.Sprintf(BreathingRateRangeURL, userID, startDate, endDate))
+}
+
+func (b *BreathingRate) SummaryByDateRange(startDate string, endDate string) (*BreathingRateSummaryResponse, error) {
+	return b.SummaryByDateRangeByID("-", startDate, endDate)
+}
+
+// IntradayByID breathing rate by sleep stage of date
+func (b *BreathingRate) IntradayByID(userID string, date string) (*BreathingRateIntradayResponse, error) {
+	return b.getIntraday(fmt.Sprintf(BreathingRateIntradayURL, userID, date))
+}
+
+func (b *BreathingRate) Intraday(date string) (*BreathingRateIntradayResponse, error) {
+	return b.IntradayByID("-", date)
+}
+
+// IntradayByDateRangeByID breathing rate by sleep stage between startDate and endDate
+func (b *BreathingRate) IntradayByDateRangeByID(userID string, startDate string, endDate string) (*BreathingRateIntradayResponse, error) {
+	return b.getIntraday(fmt.Sprintf(BreathingRateIntradayRangeURL, userID, startDate, endDate))
+}
+
+func (b *BreathingRate) IntradayByDateRange(startDate string, endDate string) (*BreathingRateIntradayResponse, error) {
+	return b.IntradayByDateRangeByID("-", startDate, endDate)
+}
@@ -0,0 +1,69 @@
+package fitbit
+
+import (
+	"errors"
+	"time"
+)
+
+// CalorieEstimator estimate calories from MET values without api call
+type CalorieEstimator struct {
+	// WeightKg user's weight in kilograms
+	WeightKg float64
+	// BMR user's basal metabolic rate in kcal per day. used instead of WeightKg when set
+	BMR float64
+}
+
+// Estimate calories burned doing activity of mets for duration
+func (e *CalorieEstimator) Estimate(mets float64, duration time.Duration) (float64, error) {
+	if mets <= 0 {
+		return 0, errors.New("mets must be positive")
+	}
+	if e.BMR > 0 {
+		return mets * e.BMR / (24 * 60) * duration.Minutes(), nil
+	}
+	if e.WeightKg > 0 {
+		return mets * e.WeightKg * duration.Hours(), nil
+	}
+	return 0, errors.New("weight or bmr is required")
+}
+
+// EstimateActivityType estimate calories of activity type. speedMPH is used
+// to pick activity level when activity type has speed bands
+func (e *CalorieEstimator) EstimateActivityType(activityType *ActivityType, duration time.Duration, speedMPH float64) (float64, error) {
+	mets := activityType.Mets
+	if level, ok := activityType.LevelForSpeed(speedMPH); ok {
+		mets = level.Mets
+	}
+	return e.Estimate(mets, duration)
+}
+
+// EstimateFavoriteActivity estimate calories of favorite activity
+func (e *CalorieEstimator) EstimateFavoriteActivity(favoriteActivity *FavoriteActivity, duration time.Duration) (float64, error) {
+	return e.Estimate(favoriteActivity.Mets, duration)
+}
+
+// LevelForSpeed return activity level matching speedMPH. speed out of the
+// bands falls back to the slowest or fastest level. false when activity type
+// has no speed bands
+func (t *ActivityType) LevelForSpeed(speedMPH float64) (*ActivityLevel, bool) {
+	if !t.HasSpeed || len(t.ActivityLevels) == 0 {
+		return nil, false
+	}
+
+	slowest, fastest := t.ActivityLevels[0], t.ActivityLevels[0]
+	for _, level := range t.ActivityLevels {
+		if level.MinSpeedMPH <= speedMPH && speedMPH < level.MasSpeedMPH {
+			return level, true
+		}
+		if level.MinSpeedMPH < slowest.MinSpeedMPH {
+			slowest = level
+		}
+		if level.MasSpeedMPH > fastest.MasSpeedMPH {
+			fastest = level
+		}
+	}
+	if speedMPH < slowest.MinSpeedMPH {
+		return slowest, true
+	}
+	return fastest, true
+}
@@ -0,0 +1,109 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	CardioScoreURL      string = "https://api.fitbit.com/1/user/%s/cardioscore/date/%s.json"
+	CardioScoreRangeURL string = "https://api.fitbit.com/1/user/%s/cardioscore/date/%s/%s.json"
+)
+
+// CardioScore fitbit cardio fitness score api
+type CardioScore struct {
+	c *Client
+}
+
+// VO2Max estimated VO2 max. Min equals Max when api returns a single number
+type VO2Max struct {
+	Min float64
+	Max float64
+}
+
+// IsRange return true when VO2 max is estimated as range
+func (v VO2Max) IsRange() bool {
+	return v.Min != v.Max
+}
+
+// UnmarshalJSON accept number, numeric string and "low-high" range string
+func (v *VO2Max) UnmarshalJSON(data []byte) error {
+	var number float64
+	if err := json.Unmarshal(data, &number); err == nil {
+		v.Min, v.Max = number, number
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	parts := strings.SplitN(str, "-", 2)
+	min, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return fmt.Errorf("invalid vo2Max:%s", str)
+	}
+	max := min
+	if len(parts) == 2 {
+		if max, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64); err != nil {
+			return fmt.Errorf("invalid vo2Max:%s", str)
+		}
+	}
+	v.Min, v.Max = min, max
+	return nil
+}
+
+// MarshalJSON same format as api returns
+func (v VO2Max) MarshalJSON() ([]byte, error) {
+	if v.IsRange() {
+		return json.Marshal(strconv.FormatFloat(v.Min, 'f', -1, 64) + "-" + strconv.FormatFloat(v.Max, 'f', -1, 64))
+	}
+	return json.Marshal(v.Min)
+}
+
+// CardioScoreValue cardio fitness score value
+type CardioScoreValue struct {
+	VO2Max VO2Max `json:"vo2Max"`
+}
+
+// CardioScoreDay cardio fitness score of a day
+type CardioScoreDay struct {
+	DateTime string            `json:"dateTime"`
+	Value    *CardioScoreValue `json:"value"`
+}
+
+type CardioScoreResponse struct {
+	CardioScore []*CardioScoreDay `json:"cardioScore"`
+}
+
+func (c *CardioScore) get(url string) (*CardioScoreResponse, error) {
+	responseByteArray, err := c.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &CardioScoreResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ByDateByID cardio fitness score of date
+func (c *CardioScore) ByDateByID(userID string, date string) (*CardioScoreResponse, error) {
+	return c.get(fmt.Sprintf(CardioScoreURL, userID, date))
+}
+
+func (c *CardioScore) ByDate(date string) (*CardioScoreResponse, error) {
+	return c.ByDateByID("-", date)
+}
+
+// ByDateRangeByID cardio fitness score between startDate and endDate
+func (c *CardioScore) ByDateRangeByID(userID string, startDate string, endDate string) (*CardioScoreResponse, error) {
+	return c.get(fmt.Sprintf(CardioScoreRangeURL, userID, startDate, endDate))
+}
+
+func (c *CardioScore) ByDateRange(startDate string, endDate string) (*CardioScoreResponse, error) {
+	return c.ByDateRangeByID("-", startDate, endDate)
+}
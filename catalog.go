@@ -0,0 +1,212 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+)
+
+// ActivityCatalog in-memory index of activity types
+type ActivityCatalog struct {
+	response   *BrowseActivityTypesResponse
+	types      []*ActivityType
+	byID       map[uint64]*ActivityType
+	categoryOf map[uint64]*Category
+}
+
+// NewActivityCatalog build index from BrowseActivityTypes response
+func NewActivityCatalog(response *BrowseActivityTypesResponse) *ActivityCatalog {
+	catalog := &ActivityCatalog{
+		response:   response,
+		byID:       map[uint64]*ActivityType{},
+		categoryOf: map[uint64]*Category{},
+	}
+	catalog.index(response.Categories)
+	return catalog
+}
+
+func (c *ActivityCatalog) index(categories []*Category) {
+	for _, category := range categories {
+		for _, activityType := range category.Activities {
+			if _, ok := c.byID[activityType.ID]; ok {
+				continue
+			}
+			c.byID[activityType.ID] = activityType
+			c.categoryOf[activityType.ID] = category
+			c.types = append(c.types, activityType)
+		}
+		c.index(category.SubCategories)
+	}
+}
+
+// ActivityCatalog fetch activity types and build index
+func (a *Activity) ActivityCatalog() (*ActivityCatalog, error) {
+	response, err := a.BrowseActivityTypes()
+	if err != nil {
+		return nil, err
+	}
+	return NewActivityCatalog(response), nil
+}
+
+// LoadActivityCatalogFromFile build index from saved BrowseActivityTypes json
+func LoadActivityCatalogFromFile(filename string) (*ActivityCatalog, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	text, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	response := &BrowseActivityTypesResponse{}
+	if err = json.Unmarshal(text, response); err != nil {
+		return nil, err
+	}
+	return NewActivityCatalog(response), nil
+}
+
+// SaveToFile save catalog as json which LoadActivityCatalogFromFile can read
+func (c *ActivityCatalog) SaveToFile(filename string) error {
+	text, err := json.Marshal(c.response)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, text, 0644)
+}
+
+// Categories return top level categories
+func (c *ActivityCatalog) Categories() []*Category {
+	return c.response.Categories
+}
+
+// ActivityTypes return all activity types
+func (c *ActivityCatalog) ActivityTypes() []*ActivityType {
+	return c.types
+}
+
+// ByID return activity type of activityID
+func (c *ActivityCatalog) ByID(activityID uint64) (*ActivityType, bool) {
+	activityType, ok := c.byID[activityID]
+	return activityType, ok
+}
+
+// CategoryOf return category which activity type belongs to
+func (c *ActivityCatalog) CategoryOf(activityID uint64) (*Category, bool) {
+	category, ok := c.categoryOf[activityID]
+	return category, ok
+}
+
+// SearchByName return activity types whose name contains query. case-insensitive
+func (c *ActivityCatalog) SearchByName(query string) []*ActivityType {
+	query = strings.ToLower(query)
+	var result []*ActivityType
+	for _, activityType := range c.types {
+		if strings.Contains(strings.ToLower(activityType.Name), query) {
+			result = append(result, activityType)
+		}
+	}
+	return result
+}
+
+// FuzzySearchByName return activity types similar to query, closest first.
+// typo up to a third of query length is tolerated
+func (c *ActivityCatalog) FuzzySearchByName(query string) []*ActivityType {
+	query = strings.ToLower(query)
+	threshold := len(query) / 3
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	type scored struct {
+		activityType *ActivityType
+		score        int
+	}
+	var candidates []scored
+	for _, activityType := range c.types {
+		score := nameDistance(query, strings.ToLower(activityType.Name))
+		if score <= threshold {
+			candidates = append(candidates, scored{activityType: activityType, score: score})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score < candidates[j].score
+		}
+		return candidates[i].activityType.Name < candidates[j].activityType.Name
+	})
+
+	result := make([]*ActivityType, 0, len(candidates))
+	for _, candidate := range candidates {
+		result = append(result, candidate.activityType)
+	}
+	return result
+}
+
+// FilterByMETs return activity types which have mets between min and max
+func (c *ActivityCatalog) FilterByMETs(min, max float64) []*ActivityType {
+	var result []*ActivityType
+	for _, activityType := range c.types {
+		if activityType.Mets > 0 && min <= activityType.Mets && activityType.Mets <= max {
+			result = append(result, activityType)
+			continue
+		}
+		for _, level := range activityType.ActivityLevels {
+			if min <= level.Mets && level.Mets <= max {
+				result = append(result, activityType)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// nameDistance distance between query and name. 0 if name contains query,
+// otherwise the smallest edit distance to the whole name or one of its words
+func nameDistance(query, name string) int {
+	if strings.Contains(name, query) {
+		return 0
+	}
+	distance := levenshtein(query, name)
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return r == ' ' || r == '(' || r == ')' || r == ',' || r == '-' || r == '/'
+	}) {
+		if d := levenshtein(query, word); d < distance {
+			distance = d
+		}
+	}
+	return distance
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+func minInt(values ...int) int {
+	min := values[0]
+	for _, v := range values[1:] {
+		if v < min {
+			min = v
+		}
+	}
+	return min
+}
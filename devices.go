@@ -0,0 +1,243 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type AlarmVibe string
+
+const (
+	AlarmVibeDefault AlarmVibe = "DEFAULT"
+
+	GetDevicesURL string = "https://api.fitbit.com/1/user/%s/devices.json"
+	AlarmsURL     string = "https://api.fitbit.com/1/user/-/devices/tracker/%s/alarms.json"
+	AlarmURL      string = "https://api.fitbit.com/1/user/-/devices/tracker/%s/alarms/%d.json"
+)
+
+// Devices fitbit devices api
+type Devices struct {
+	c *Client
+}
+
+// Device paired device
+type Device struct {
+	Battery       string    `json:"battery"`
+	BatteryLevel  uint64    `json:"batteryLevel"`
+	DeviceVersion string    `json:"deviceVersion"`
+	Features      []string  `json:"features"`
+	ID            string    `json:"id"`
+	LastSyncTime  time.Time `json:"-"`
+	MAC           string    `json:"mac"`
+	Type          string    `json:"type"`
+	// RawLastSyncTime as returned by api. LastSyncTime is zero when
+	// timezone of the user is unknown
+	RawLastSyncTime string `json:"lastSyncTime"`
+}
+
+var weekdayNames = map[time.Weekday]string{
+	time.Sunday:    "SUNDAY",
+	time.Monday:    "MONDAY",
+	time.Tuesday:   "TUESDAY",
+	time.Wednesday: "WEDNESDAY",
+	time.Thursday:  "THURSDAY",
+	time.Friday:    "FRIDAY",
+	time.Saturday:  "SATURDAY",
+}
+
+// WeekdaySet set of weekdays
+type WeekdaySet uint8
+
+// NewWeekdaySet return set of days
+func NewWeekdaySet(days ...time.Weekday) WeekdaySet {
+	var set WeekdaySet
+	for _, day := range days {
+		set |= 1 << uint(day)
+	}
+	return set
+}
+
+// Has return true if set contains day
+func (s WeekdaySet) Has(day time.Weekday) bool {
+	return s&(1<<uint(day)) != 0
+}
+
+// Days return days in set from Sunday
+func (s WeekdaySet) Days() []time.Weekday {
+	var days []time.Weekday
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if s.Has(day) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+func (s WeekdaySet) names() []string {
+	names := []string{}
+	for _, day := range s.Days() {
+		names = append(names, weekdayNames[day])
+	}
+	return names
+}
+
+// String comma separated weekday names as api expects
+func (s WeekdaySet) String() string {
+	return strings.Join(s.names(), ",")
+}
+
+func (s WeekdaySet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.names())
+}
+
+func (s *WeekdaySet) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+	*s = 0
+	for _, name := range names {
+		found := false
+		for day, dayName := range weekdayNames {
+			if strings.EqualFold(name, dayName) {
+				*s |= NewWeekdaySet(day)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown weekday:%s", name)
+		}
+	}
+	return nil
+}
+
+// Alarm tracker alarm
+type Alarm struct {
+	AlarmID        uint64     `json:"alarmId"`
+	Deleted        bool       `json:"deleted"`
+	Enabled        bool       `json:"enabled"`
+	Recurring      bool       `json:"recurring"`
+	SnoozeCount    uint64     `json:"snoozeCount"`
+	SnoozeLength   uint64     `json:"snoozeLength"`
+	SyncedToDevice bool       `json:"syncedToDevice"`
+	Time           string     `json:"time"`
+	Vibe           AlarmVibe  `json:"vibe"`
+	WeekDays       WeekdaySet `json:"weekDays"`
+}
+
+type AlarmsResponse struct {
+	TrackerAlarms []*Alarm `json:"trackerAlarms"`
+}
+
+type AlarmResponse struct {
+	TrackerAlarm *Alarm `json:"trackerAlarm"`
+}
+
+// AlarmParams parameters of CreateAlarm and UpdateAlarm. Time is "HH:mm" with
+// timezone offset such as "07:15-08:00". SnoozeLength, SnoozeCount and Vibe
+// are only sent by UpdateAlarm
+type AlarmParams struct {
+	Time         string
+	Enabled      bool
+	Recurring    bool
+	WeekDays     WeekdaySet
+	SnoozeLength uint64
+	SnoozeCount  uint64
+	Vibe         AlarmVibe
+}
+
+func (p *AlarmParams) values() url.Values {
+	values := url.Values{}
+	values.Add("time", p.Time)
+	values.Add("enabled", strconv.FormatBool(p.Enabled))
+	values.Add("recurring", strconv.FormatBool(p.Recurring))
+	values.Add("weekDays", p.WeekDays.String())
+	return values
+}
+
+// GetDevicesByID paired devices
+func (d *Devices) GetDevicesByID(userID string) ([]Device, error) {
+	responseByteArray, err := d.c.Get(fmt.Sprintf(GetDevicesURL, userID))
+	if err != nil {
+		return nil, err
+	}
+
+	var response []Device
+	if err = json.Unmarshal(responseByteArray, &response); err != nil {
+		return nil, err
+	}
+	var location *time.Location
+	for i := range response {
+		if response[i].RawLastSyncTime == "" {
+			continue
+		}
+		if location == nil {
+			var ok bool
+			if location, ok = d.c.locationOf(userID); !ok {
+				break
+			}
+		}
+		response[i].LastSyncTime, err = time.ParseInLocation("2006-01-02T15:04:05", response[i].RawLastSyncTime, location)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (d *Devices) GetDevices() ([]Device, error) {
+	return d.GetDevicesByID("-")
+}
+
+// GetAlarms alarms of tracker
+func (d *Devices) GetAlarms(trackerID string) (*AlarmsResponse, error) {
+	responseByteArray, err := d.c.Get(fmt.Sprintf(AlarmsURL, trackerID))
+	if err != nil {
+		return nil, err
+	}
+	response := &AlarmsResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (d *Devices) postAlarm(url string, values url.Values) (*AlarmResponse, error) {
+	responseByteArray, err := d.c.PostForm(url, values)
+	if err != nil {
+		return nil, err
+	}
+	response := &AlarmResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CreateAlarm add alarm to tracker
+func (d *Devices) CreateAlarm(trackerID string, params *AlarmParams) (*AlarmResponse, error) {
+	return d.postAlarm(fmt.Sprintf(AlarmsURL, trackerID), params.values())
+}
+
+// UpdateAlarm update alarm of tracker
+func (d *Devices) UpdateAlarm(trackerID string, alarmID uint64, params *AlarmParams) (*AlarmResponse, error) {
+	values := params.values()
+	values.Add("snoozeLength", strconv.FormatUint(params.SnoozeLength, 10))
+	values.Add("snoozeCount", strconv.FormatUint(params.SnoozeCount, 10))
+	vibe := params.Vibe
+	if vibe == "" {
+		vibe = AlarmVibeDefault
+	}
+	values.Add("vibe", string(vibe))
+	return d.postAlarm(fmt.Sprintf(AlarmURL, trackerID, alarmID), values)
+}
+
+// DeleteAlarm delete alarm of tracker
+func (d *Devices) DeleteAlarm(trackerID string, alarmID uint64) error {
+	return d.c.Delete(fmt.Sprintf(AlarmURL, trackerID, alarmID))
+}
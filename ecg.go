@@ -0,0 +1,139 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+type ECGClassification string
+
+const (
+	NormalSinusRhythm         ECGClassification = "Normal Sinus Rhythm"
+	AtrialFibrillation        ECGClassification = "Atrial Fibrillation"
+	Inconclusive              ECGClassification = "Inconclusive"
+	InconclusiveHighHeartRate ECGClassification = "Inconclusive: High heart rate"
+	InconclusiveLowHeartRate  ECGClassification = "Inconclusive: Low heart rate"
+	Unreadable                ECGClassification = "Unreadable"
+
+	ECGLogListURL string = "https://api.fitbit.com/1/user/%s/ecg/list.json"
+
+	ecgLogListMaxLimit uint64 = 10
+)
+
+// ECG fitbit electrocardiogram api
+type ECG struct {
+	c *Client
+}
+
+// ECGReading single ECG reading
+type ECGReading struct {
+	StartTime               string            `json:"startTime"`
+	AverageHeartRate        uint64            `json:"averageHeartRate"`
+	ResultClassification    ECGClassification `json:"resultClassification"`
+	WaveformSamples         []int64           `json:"waveformSamples"`
+	SamplingFrequencyHz     string            `json:"samplingFrequencyHz"`
+	ScalingFactor           uint64            `json:"scalingFactor"`
+	NumberOfWaveformSamples uint64            `json:"numberOfWaveformSamples"`
+	LeadNumber              uint64            `json:"leadNumber"`
+	FeatureVersion          string            `json:"featureVersion"`
+	DeviceName              string            `json:"deviceName"`
+	FirmwareVersion         string            `json:"firmwareVersion"`
+}
+
+// SamplingFrequency sampling frequency in Hz. 0 when api returns invalid value
+func (e *ECGReading) SamplingFrequency() float64 {
+	frequency, err := strconv.ParseFloat(e.SamplingFrequencyHz, 64)
+	if err != nil {
+		return 0
+	}
+	return frequency
+}
+
+// Millivolts waveform samples divided by scaling factor
+func (e *ECGReading) Millivolts() []float64 {
+	if e.ScalingFactor == 0 {
+		return nil
+	}
+	millivolts := make([]float64, len(e.WaveformSamples))
+	for i, sample := range e.WaveformSamples {
+		millivolts[i] = float64(sample) / float64(e.ScalingFactor)
+	}
+	return millivolts
+}
+
+type ECGLogListResponse struct {
+	ECGReadings []*ECGReading `json:"ecgReadings"`
+	Pagination  *Pagination   `json:"pagination"`
+}
+
+func (e *ECG) getLogList(url string) (*ECGLogListResponse, error) {
+	responseByteArray, err := e.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &ECGLogListResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// LogListByID one page of ECG log list
+func (e *ECG) LogListByID(userID string, params *ListParams) (*ECGLogListResponse, error) {
+	query, err := params.query(ecgLogListMaxLimit)
+	if err != nil {
+		return nil, err
+	}
+	return e.getLogList(fmt.Sprintf(ECGLogListURL, userID) + "?" + query)
+}
+
+func (e *ECG) LogList(params *ListParams) (*ECGLogListResponse, error) {
+	return e.LogListByID("-", params)
+}
+
+// ECGLogIterator iterate ECG log list following pagination
+//
+//	it := client.ECG.LogIterator(params)
+//	for it.Next() {
+//		reading := it.Reading()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type ECGLogIterator struct {
+	pages    *pageIterator
+	readings []*ECGReading
+}
+
+// LogIteratorByID iterator of ECG log list
+func (e *ECG) LogIteratorByID(userID string, params *ListParams) *ECGLogIterator {
+	it := &ECGLogIterator{}
+	it.pages = newPageIterator(fmt.Sprintf(ECGLogListURL, userID), params, ecgLogListMaxLimit, func(url string) (int, *Pagination, error) {
+		response, err := e.getLogList(url)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.readings = response.ECGReadings
+		return len(it.readings), response.Pagination, nil
+	})
+	return it
+}
+
+func (e *ECG) LogIterator(params *ListParams) *ECGLogIterator {
+	return e.LogIteratorByID("-", params)
+}
+
+// Next advance to next reading. fetch next page when needed
+func (it *ECGLogIterator) Next() bool {
+	return it.pages.advance()
+}
+
+// Reading current reading
+func (it *ECGLogIterator) Reading() *ECGReading {
+	return it.readings[it.pages.index]
+}
+
+// Err error which stopped iteration
+func (it *ECGLogIterator) Err() error {
+	return it.pages.err
+}
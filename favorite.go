@@ -0,0 +1,110 @@
+package fitbit
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// FavoriteActivitiesDiff activity ids to add to and delete from favorites
+type FavoriteActivitiesDiff struct {
+	Add    []uint64
+	Delete []uint64
+}
+
+// Empty return true when favorites already match
+func (d *FavoriteActivitiesDiff) Empty() bool {
+	return len(d.Add) == 0 && len(d.Delete) == 0
+}
+
+// FavoriteActivityError failure of add or delete for one activity
+type FavoriteActivityError struct {
+	ActivityID uint64
+	Operation  string
+	Err        error
+}
+
+func (e *FavoriteActivityError) Error() string {
+	return fmt.Sprintf("%s favorite activity %d failed. %s", e.Operation, e.ActivityID, e.Err.Error())
+}
+
+// ReconcileFavoriteActivitiesResult result of ReconcileFavoriteActivities
+type ReconcileFavoriteActivitiesResult struct {
+	Diff    *FavoriteActivitiesDiff
+	Added   []uint64
+	Deleted []uint64
+	Errors  []*FavoriteActivityError
+}
+
+// DiffFavoriteActivitiesByID compare current favorites of user with activityIDs
+func (a *Activity) DiffFavoriteActivitiesByID(userID string, activityIDs []uint64) (*FavoriteActivitiesDiff, error) {
+	favoriteActivities, err := a.GetFavoriteActivitiesByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return diffFavoriteActivities(favoriteActivities, activityIDs), nil
+}
+
+func (a *Activity) DiffFavoriteActivities(activityIDs []uint64) (*FavoriteActivitiesDiff, error) {
+	return a.DiffFavoriteActivitiesByID("-", activityIDs)
+}
+
+// ReconcileFavoriteActivitiesByID make favorites of user equal to activityIDs,
+// calling only the needed add and delete. nothing is changed when dryRun is true.
+// failures of each activity are reported in result, not as error
+func (a *Activity) ReconcileFavoriteActivitiesByID(userID string, activityIDs []uint64, dryRun bool) (*ReconcileFavoriteActivitiesResult, error) {
+	diff, err := a.DiffFavoriteActivitiesByID(userID, activityIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := &ReconcileFavoriteActivitiesResult{Diff: diff}
+	if dryRun {
+		return result, nil
+	}
+
+	for _, activityID := range diff.Add {
+		if err := a.AddFavoriteActivityByID(userID, strconv.FormatUint(activityID, 10)); err != nil {
+			result.Errors = append(result.Errors, &FavoriteActivityError{ActivityID: activityID, Operation: "add", Err: err})
+			continue
+		}
+		result.Added = append(result.Added, activityID)
+	}
+	for _, activityID := range diff.Delete {
+		if err := a.DeleteFavoriteActivityByID(userID, strconv.FormatUint(activityID, 10)); err != nil {
+			result.Errors = append(result.Errors, &FavoriteActivityError{ActivityID: activityID, Operation: "delete", Err: err})
+			continue
+		}
+		result.Deleted = append(result.Deleted, activityID)
+	}
+	return result, nil
+}
+
+func (a *Activity) ReconcileFavoriteActivities(activityIDs []uint64, dryRun bool) (*ReconcileFavoriteActivitiesResult, error) {
+	return a.ReconcileFavoriteActivitiesByID("-", activityIDs, dryRun)
+}
+
+func diffFavoriteActivities(favoriteActivities []FavoriteActivity, activityIDs []uint64) *FavoriteActivitiesDiff {
+	current := map[uint64]bool{}
+	for _, favoriteActivity := range favoriteActivities {
+		current[favoriteActivity.ActivityID] = true
+	}
+	target := map[uint64]bool{}
+	for _, activityID := range activityIDs {
+		target[activityID] = true
+	}
+
+	diff := &FavoriteActivitiesDiff{}
+	for activityID := range target {
+		if !current[activityID] {
+			diff.Add = append(diff.Add, activityID)
+		}
+	}
+	for activityID := range current {
+		if !target[activityID] {
+			diff.Delete = append(diff.Delete, activityID)
+		}
+	}
+	sort.Slice(diff.Add, func(i, j int) bool { return diff.Add[i] < diff.Add[j] })
+	sort.Slice(diff.Delete, func(i, j int) bool { return diff.Delete[i] < diff.Delete[j] })
+	return diff
+}
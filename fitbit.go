@@ -2,17 +2,37 @@
 package fitbit
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"golang.org/x/oauth2"
 )
 
+type ListSort string
+type UnitSystem string
+
+const (
+	SortAsc  ListSort = "asc"
+	SortDesc ListSort = "desc"
+
+	// UnitSystemMetric kilograms, centimeters, milliliters
+	UnitSystemMetric UnitSystem = ""
+	// UnitSystemUS pounds, inches, fluid ounces
+	UnitSystemUS UnitSystem = "en_US"
+	// UnitSystemUK stone, centimeters, milliliters
+	UnitSystemUK UnitSystem = "en_GB"
+)
+
 // Config get *oauth2.Config from env variables
 func Config() *oauth2.Config {
 	c := &oauth2.Config{
@@ -36,8 +56,27 @@ type Fitbit struct {
 
 // Client hogehoge
 type Client struct {
-	httpClient *http.Client
-	Activity   *Activity
+	httpClient    *http.Client
+	Activity      *Activity
+	HeartRate     *HeartRate
+	Sleep         *Sleep
+	Body          *Body
+	Food          *Food
+	Water         *Water
+	Devices       *Devices
+	User          *User
+	Social        *Social
+	Subscriptions *Subscriptions
+	SpO2          *SpO2
+	BreathingRate *BreathingRate
+	HRV           *HRV
+	Temperature   *Temperature
+	CardioScore   *CardioScore
+	ECG           *ECG
+	IRN           *IRN
+	unitSystem    UnitSystem
+	mutex         sync.RWMutex
+	profile       *UserProfile
 }
 
 // SetConfig set *oauth2.Config
@@ -103,12 +142,217 @@ func (f *Fitbit) Client() (*Client, error) {
 	}
 	client := &Client{httpClient: f.config.Client(oauth2.NoContext, f.token)}
 	client.Activity = &Activity{c: client}
+	client.HeartRate = &HeartRate{c: client}
+	client.Sleep = &Sleep{c: client}
+	client.Body = &Body{c: client}
+	client.Food = &Food{c: client}
+	client.Water = &Water{c: client}
+	client.Devices = &Devices{c: client}
+	client.User = &User{c: client}
+	client.Social = &Social{c: client}
+	client.Subscriptions = &Subscriptions{c: client}
+	client.SpO2 = &SpO2{c: client}
+	client.BreathingRate = &BreathingRate{c: client}
+	client.HRV = &HRV{c: client}
+	client.Temperature = &Temperature{c: client}
+	client.CardioScore = &CardioScore{c: client}
+	client.ECG = &ECG{c: client}
+	client.IRN = &IRN{c: client}
 	return client, nil
 }
 
+// location user's timezone used to parse times returned by api. profile is
+// loaded if not cached
+func (c *Client) location() (*time.Location, error) {
+	profile := c.Profile()
+	if profile == nil {
+		var err error
+		if profile, err = c.LoadProfile(); err != nil {
+			return nil, err
+		}
+	}
+	return profile.Location(), nil
+}
+
+// locationOf timezone of userID used to parse times returned by api. false
+// when it is unknown, that is userID isn't the authorized user or profile
+// can't be loaded
+func (c *Client) locationOf(userID string) (*time.Location, bool) {
+	location, err := c.location()
+	if err != nil {
+		return nil, false
+	}
+	if userID != "-" && userID != c.Profile().EncodedID {
+		return nil, false
+	}
+	return location, true
+}
+
+// APIError fitbit api error detail
+type APIError struct {
+	ErrorType string `json:"errorType"`
+	FieldName string `json:"fieldName"`
+	Message   string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	if e.FieldName == "" {
+		return fmt.Sprintf("%s: %s", e.ErrorType, e.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", e.ErrorType, e.FieldName, e.Message)
+}
+
+// ErrorResponse fitbit api error response
+type ErrorResponse struct {
+	StatusCode int         `json:"-"`
+	Errors     []*APIError `json:"errors"`
+}
+
+func (e *ErrorResponse) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, apiError := range e.Errors {
+		messages = append(messages, apiError.Error())
+	}
+	return fmt.Sprintf("request failed. status code:%d %s", e.StatusCode, strings.Join(messages, ", "))
+}
+
+// ValidationErrors return errors whose errorType is validation
+func (e *ErrorResponse) ValidationErrors() []*APIError {
+	var validationErrors []*APIError
+	for _, apiError := range e.Errors {
+		if apiError.ErrorType == "validation" {
+			validationErrors = append(validationErrors, apiError)
+		}
+	}
+	return validationErrors
+}
+
+func newErrorResponse(statusCode int, responseByteArray []byte) *ErrorResponse {
+	errorResponse := &ErrorResponse{}
+	// body isn't always json. status code is enough in that case
+	json.Unmarshal(responseByteArray, errorResponse)
+	errorResponse.StatusCode = statusCode
+	return errorResponse
+}
+
+// Pagination pagination of list api
+type Pagination struct {
+	AfterDate  string `json:"afterDate"`
+	BeforeDate string `json:"beforeDate"`
+	Limit      uint64 `json:"limit"`
+	Next       string `json:"next"`
+	Offset     uint64 `json:"offset"`
+	Previous   string `json:"previous"`
+	Sort       string `json:"sort"`
+}
+
+// ListParams parameters of list api. set either BeforeDate or AfterDate.
+// Sort must be SortDesc with BeforeDate and SortAsc with AfterDate.
+// Limit 0 means the maximum limit of each api
+type ListParams struct {
+	BeforeDate string
+	AfterDate  string
+	Sort       ListSort
+	Limit      uint64
+	Offset     uint64
+}
+
+// query encode params. maxLimit is the maximum limit api accepts
+func (p *ListParams) query(maxLimit uint64) (string, error) {
+	if (p.BeforeDate == "") == (p.AfterDate == "") {
+		return "", errors.New("either beforeDate or afterDate is required")
+	}
+	values := url.Values{}
+	if p.BeforeDate != "" {
+		values.Add("beforeDate", p.BeforeDate)
+	}
+	if p.AfterDate != "" {
+		values.Add("afterDate", p.AfterDate)
+	}
+	sort := p.Sort
+	if sort == "" {
+		sort = SortDesc
+		if p.AfterDate != "" {
+			sort = SortAsc
+		}
+	}
+	values.Add("sort", string(sort))
+	limit := p.Limit
+	if limit == 0 {
+		limit = maxLimit
+	}
+	if limit > maxLimit {
+		return "", fmt.Errorf("limit must be between 1 and %d", maxLimit)
+	}
+	values.Add("limit", strconv.FormatUint(limit, 10))
+	values.Add("offset", strconv.FormatUint(p.Offset, 10))
+	return values.Encode(), nil
+}
+
+// pageIterator follow pagination of list api. fetch stores items of page at
+// url and returns number of them with pagination of the page
+type pageIterator struct {
+	fetch func(url string) (int, *Pagination, error)
+	next  string
+	count int
+	index int
+	err   error
+}
+
+func newPageIterator(listURL string, params *ListParams, maxLimit uint64, fetch func(url string) (int, *Pagination, error)) *pageIterator {
+	it := &pageIterator{fetch: fetch, index: -1}
+	query, err := params.query(maxLimit)
+	if err != nil {
+		it.err = err
+		return it
+	}
+	it.next = listURL + "?" + query
+	return it
+}
+
+// advance move to next item. fetch next page when needed
+func (it *pageIterator) advance() bool {
+	if it.err != nil {
+		return false
+	}
+	it.index++
+	for it.index >= it.count {
+		if it.next == "" {
+			return false
+		}
+		count, pagination, err := it.fetch(it.next)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.count, it.index, it.next = count, 0, ""
+		if pagination != nil {
+			it.next = pagination.Next
+		}
+	}
+	return true
+}
+
+// SetUnitSystem set unit system of values sent and returned
+func (c *Client) SetUnitSystem(unitSystem UnitSystem) {
+	c.unitSystem = unitSystem
+}
+
+func (c *Client) do(request *http.Request) (*http.Response, error) {
+	if c.unitSystem != UnitSystemMetric {
+		request.Header.Set("Accept-Language", string(c.unitSystem))
+	}
+	return c.httpClient.Do(request)
+}
+
 // Get do GetRequest specific url
 func (c *Client) Get(url string) ([]byte, error) {
-	result, err := c.httpClient.Get(url)
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -124,8 +368,66 @@ func (c *Client) Get(url string) ([]byte, error) {
 	return responseByteArray, nil
 }
 
+// PostForm do PostRequest with form values and return response body
+func (c *Client) PostForm(targetURL string, values url.Values) ([]byte, error) {
+	request, err := http.NewRequest("POST", targetURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	result, err := c.do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Body.Close()
+
+	responseByteArray, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+	if result.StatusCode != 200 && result.StatusCode != 201 {
+		return nil, newErrorResponse(result.StatusCode, responseByteArray)
+	}
+	return responseByteArray, nil
+}
+
+// PostJSON do PostRequest with json body and return response body
+func (c *Client) PostJSON(targetURL string, body interface{}) ([]byte, error) {
+	bodyByteArray, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequest("POST", targetURL, bytes.NewReader(bodyByteArray))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	result, err := c.do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Body.Close()
+
+	responseByteArray, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+	if result.StatusCode != 200 && result.StatusCode != 201 {
+		return nil, newErrorResponse(result.StatusCode, responseByteArray)
+	}
+	return responseByteArray, nil
+}
+
 func (c *Client) Post(url string) error {
-	result, err := c.httpClient.Post(url, "application/x-www-form-urlencoded", nil)
+	request, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	result, err := c.do(request)
 	if err != nil {
 		return err
 	}
@@ -143,7 +445,7 @@ func (c *Client) Delete(url string) error {
 		return err
 	}
 
-	result, err := c.httpClient.Do(request)
+	result, err := c.do(request)
 	if err != nil {
 		return err
 	}
@@ -1,8 +1,19 @@
 package fitbit
 
 import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
 )
 
 func Prepare() (*Client, error) {
@@ -165,3 +176,1026 @@ func TestGetFavoriteActivities(t *testing.T) {
 		fmt.Println(favoriteActivity.Name)
 	}
 }
+
+func TestParseTCX(t *testing.T) {
+	tcxByteArray := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<TrainingCenterDatabase xmlns="http://www.garmin.com/xmlschemas/TrainingCenterDatabase/v2">
+  <Activities>
+    <Activity Sport="Running">
+      <Id>2015-11-23T07:47:49.000+09:00</Id>
+      <Lap StartTime="2015-11-23T07:47:49.000+09:00">
+        <TotalTimeSeconds>600.0</TotalTimeSeconds>
+        <DistanceMeters>1500.5</DistanceMeters>
+        <Calories>120</Calories>
+        <Intensity>Active</Intensity>
+        <TriggerMethod>Manual</TriggerMethod>
+        <Track>
+          <Trackpoint>
+            <Time>2015-11-23T07:47:49.000+09:00</Time>
+            <Position>
+              <LatitudeDegrees>35.6812</LatitudeDegrees>
+              <LongitudeDegrees>139.7671</LongitudeDegrees>
+            </Position>
+            <AltitudeMeters>12.3</AltitudeMeters>
+            <DistanceMeters>0.0</DistanceMeters>
+            <HeartRateBpm>
+              <Value>98</Value>
+            </HeartRateBpm>
+          </Trackpoint>
+        </Track>
+      </Lap>
+    </Activity>
+  </Activities>
+</TrainingCenterDatabase>`)
+
+	tcx, err := ParseTCX(tcxByteArray)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	trackpoints := tcx.Trackpoints()
+	if len(trackpoints) != 1 {
+		t.Errorf("trackpoint size:%d", len(trackpoints))
+		return
+	}
+	if trackpoints[0].HeartRateBpm != 98 || trackpoints[0].Position == nil || trackpoints[0].Position.LatitudeDegrees != 35.6812 {
+		t.Errorf("unexpected trackpoint:%+v", trackpoints[0])
+	}
+	if tcx.Activities[0].Laps[0].Calories != 120 {
+		t.Errorf("unexpected lap:%+v", tcx.Activities[0].Laps[0])
+	}
+}
+
+func TestActivityLogConvertTracker(t *testing.T) {
+	resultByteArray := []byte(`{"activities-tracker-steps":[{"dateTime":"2015-11-20","value":"8123"},{"dateTime":"2015-11-21","value":"10234"}]}`)
+	response, err := activityLogConvert(resultByteArray, TrackerStepsLog)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(response.Logs) != 2 || response.Logs[1].Value != "10234" {
+		t.Errorf("unexpected logs:%+v", response.Logs)
+	}
+}
+
+func TestActivityGoalParamsValues(t *testing.T) {
+	params := &ActivityGoalParams{CaloriesOut: Uint64(2500), Distance: Float64(8.05)}
+	values := params.values()
+	if len(values) != 2 {
+		t.Errorf("unexpected values:%v", values)
+	}
+	if values.Get("caloriesOut") != "2500" || values.Get("distance") != "8.05" {
+		t.Errorf("unexpected values:%v", values)
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	errorResponse := newErrorResponse(400, []byte(`{"errors":[{"errorType":"validation","fieldName":"steps","message":"Invalid steps value"}],"success":false}`))
+	validationErrors := errorResponse.ValidationErrors()
+	if len(validationErrors) != 1 || validationErrors[0].FieldName != "steps" {
+		t.Errorf("unexpected errors:%v", errorResponse)
+	}
+}
+
+func TestActivityResponseRaw(t *testing.T) {
+	resultByteArray := []byte(`{"activities":[{"logId":1,"source":{"id":"1","name":"Charge HR","type":"tracker"}}],"goals":{"activeMinutes":30,"steps":10000},"summary":{"activeScore":-1,"restingHeartRate":58,"heartRateZones":[{"name":"Fat Burn","min":94,"max":131,"minutes":42}]},"newField":1}`)
+	response := &ActivityResponse{}
+	if err := json.Unmarshal(resultByteArray, response); err != nil {
+		t.Error(err)
+		return
+	}
+	if response.Summary.ActiveScore != -1 || response.Summary.RestingHeartRate != 58 || response.Summary.HeartRateZones[0].Minutes != 42 {
+		t.Errorf("unexpected summary:%+v", response.Summary)
+	}
+	if response.Activities[0].Source.Name != "Charge HR" || response.Goals.ActiveMinutes != 30 {
+		t.Errorf("unexpected response:%+v", response)
+	}
+	extras := map[string]json.RawMessage{}
+	if err := json.Unmarshal(response.Raw, &extras); err != nil {
+		t.Error(err)
+		return
+	}
+	if _, ok := extras["newField"]; !ok {
+		t.Error("raw response lost unknown field")
+	}
+}
+
+func TestActivityCatalog(t *testing.T) {
+	responseByteArray := []byte(`{"categories":[{"id":1,"name":"Sports and Workouts","activities":[{"id":90009,"name":"Run","hasSpeed":true,"activityLevels":[{"id":1,"name":"jogging","mets":7,"minSpeedMPH":4,"maxSpeedMPH":5.2}]}],"subCategories":[{"id":2,"name":"Swimming","activities":[{"id":90024,"name":"Swimming","mets":6}]}]}]}`)
+	response := &BrowseActivityTypesResponse{}
+	if err := json.Unmarshal(responseByteArray, response); err != nil {
+		t.Error(err)
+		return
+	}
+	catalog := NewActivityCatalog(response)
+
+	if activityType, ok := catalog.ByID(90024); !ok || activityType.Name != "Swimming" {
+		t.Errorf("unexpected activity type:%+v", activityType)
+	}
+	if category, ok := catalog.CategoryOf(90024); !ok || category.Name != "Swimming" {
+		t.Errorf("unexpected category:%+v", category)
+	}
+	if result := catalog.SearchByName("RUN"); len(result) != 1 {
+		t.Errorf("unexpected search result:%v", result)
+	}
+	if result := catalog.FuzzySearchByName("swiming"); len(result) != 1 || result[0].ID != 90024 {
+		t.Errorf("unexpected fuzzy search result:%v", result)
+	}
+	if result := catalog.FilterByMETs(6.5, 8); len(result) != 1 || result[0].ID != 90009 {
+		t.Errorf("unexpected filter result:%v", result)
+	}
+}
+
+func TestCalorieEstimator(t *testing.T) {
+	run := &ActivityType{
+		HasSpeed: true,
+		ActivityLevels: []*ActivityLevel{
+			{ID: 1, Name: "jogging", Mets: 7, MinSpeedMPH: 4, MasSpeedMPH: 5.5},
+			{ID: 2, Name: "running", Mets: 10, MinSpeedMPH: 5.5, MasSpeedMPH: 7},
+		},
+	}
+	estimator := &CalorieEstimator{WeightKg: 60}
+	calories, err := estimator.EstimateActivityType(run, 30*time.Minute, 6)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if calories != 300 {
+		t.Errorf("unexpected calories:%f", calories)
+	}
+
+	estimator = &CalorieEstimator{BMR: 1440}
+	calories, err = estimator.EstimateActivityType(run, 30*time.Minute, 2)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if calories != 210 {
+		t.Errorf("unexpected calories:%f", calories)
+	}
+}
+
+func TestCalorieEstimatorWithoutSpeed(t *testing.T) {
+	yoga := &ActivityType{
+		Mets: 2.5,
+		ActivityLevels: []*ActivityLevel{
+			{ID: 1, Name: "power", Mets: 4},
+		},
+	}
+	if level, ok := yoga.LevelForSpeed(3); ok {
+		t.Errorf("unexpected level:%+v", level)
+	}
+	estimator := &CalorieEstimator{WeightKg: 60}
+	calories, err := estimator.EstimateActivityType(yoga, time.Hour, 3)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if calories != 150 {
+		t.Errorf("unexpected calories:%f", calories)
+	}
+}
+
+func TestDiffFavoriteActivities(t *testing.T) {
+	favoriteActivities := []FavoriteActivity{{ActivityID: 90009}, {ActivityID: 90013}}
+	diff := diffFavoriteActivities(favoriteActivities, []uint64{90013, 90024, 1010})
+	if len(diff.Add) != 2 || diff.Add[0] != 1010 || diff.Add[1] != 90024 {
+		t.Errorf("unexpected add:%v", diff.Add)
+	}
+	if len(diff.Delete) != 1 || diff.Delete[0] != 90009 {
+		t.Errorf("unexpected delete:%v", diff.Delete)
+	}
+}
+
+func TestReconcileFavoriteActivitiesByID(t *testing.T) {
+	var requests []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"activityId":90009}]`)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	result, err := client.Activity.ReconcileFavoriteActivitiesByID("ABC123", []uint64{90013}, false)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(result.Added) != 1 || len(result.Deleted) != 1 || len(result.Errors) != 0 {
+		t.Errorf("unexpected result:%+v", result)
+	}
+	expected := []string{
+		"GET /1/user/ABC123/activities/favorite.json",
+		"POST /1/user/ABC123/activities/favorite/90013.json",
+		"DELETE /1/user/ABC123/activities/favorite/90009.json",
+	}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("unexpected requests:%v", requests)
+	}
+}
+
+func TestActiveZoneMinutesTimeSeries(t *testing.T) {
+	client, err := Prepare()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	response, err := client.Activity.ActiveZoneMinutesTimeSeries("2015-11-20", OneWeek)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, day := range response.ActiveZoneMinutes {
+		fmt.Println(day.DateTime, day.Value.FatBurnActiveZoneMinutes, day.Value.CardioActiveZoneMinutes, day.Value.PeakActiveZoneMinutes)
+	}
+}
+
+func TestHeartRateIntraday(t *testing.T) {
+	client, err := Prepare()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	response, err := client.HeartRate.Intraday("2015-11-23", OneMinute, &TimeWindow{StartTime: "08:00", EndTime: "09:00"})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	fmt.Println(response.HeartRate[0].Value.RestingHeartRate)
+	for _, dataSet := range response.HeartRateIntraday.DataSet {
+		fmt.Println(dataSet.Time, dataSet.Value)
+	}
+}
+
+func TestHeartRateIntradayLocation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/1/user/-/profile.json":
+			fmt.Fprint(w, `{"user":{"timezone":"Asia/Tokyo","offsetFromUTCMillis":32400000}}`)
+		case "/1/user/-/activities/heart/date/2015-11-23/1d/1min.json":
+			fmt.Fprint(w, `{"activities-heart":[{"dateTime":"2015-11-23"}],"activities-heart-intraday":{"dataset":[{"time":"08:00:00","value":64}]}}`)
+		default:
+			fmt.Fprint(w, `{"activities-heart":[],"activities-heart-intraday":{"dataset":[{"time":"08:00:00","value":64}]}}`)
+		}
+	}))
+
+	response, err := client.HeartRate.Intraday("2015-11-23", OneMinute, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := time.Date(2015, 11, 23, 8, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	if dataSetTime := response.HeartRateIntraday.DataSet[0].Time; !dataSetTime.Equal(expected) {
+		t.Errorf("unexpected time:%s", dataSetTime)
+	}
+
+	response, err = client.HeartRate.Intraday("today", OneMinute, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if dataSetTime := response.HeartRateIntraday.DataSet[0].Time; !dataSetTime.IsZero() {
+		t.Errorf("unexpected time:%s", dataSetTime)
+	}
+
+	response, err = client.HeartRate.IntradayByID("227YZL", "2015-11-23", OneMinute, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if dataSetTime := response.HeartRateIntraday.DataSet[0].Time; !dataSetTime.IsZero() {
+		t.Errorf("time of other user is parsed:%s", dataSetTime)
+	}
+}
+
+func TestHeartRateIntradayWithoutProfile(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/1/user/-/profile.json" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errors":[{"errorType":"insufficient_scope","message":"profile scope is required"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"activities-heart":[{"dateTime":"2015-11-23"}],"activities-heart-intraday":{"dataset":[{"time":"08:00:00","value":64}]}}`)
+	}))
+
+	response, err := client.HeartRate.Intraday("2015-11-23", OneMinute, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	dataSet := response.HeartRateIntraday.DataSet[0]
+	if dataSet.Value != 64 || !dataSet.Time.IsZero() {
+		t.Errorf("unexpected data set:%+v", dataSet)
+	}
+}
+
+func TestDevicesLastSyncTime(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/1/user/-/profile.json" {
+			fmt.Fprint(w, `{"user":{"timezone":"Asia/Tokyo","offsetFromUTCMillis":32400000}}`)
+			return
+		}
+		fmt.Fprint(w, `[{"id":"1","lastSyncTime":"2015-11-23T08:00:00.000"}]`)
+	}))
+
+	devices, err := client.Devices.GetDevices()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	expected := time.Date(2015, 11, 23, 8, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	if !devices[0].LastSyncTime.Equal(expected) {
+		t.Errorf("unexpected last sync time:%s", devices[0].LastSyncTime)
+	}
+}
+
+func TestActivityIntraday(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/1/user/-/activities/steps/date/2015-11-23/1d/1min.json":
+			fmt.Fprint(w, `{"activities-steps":[{"dateTime":"2015-11-23","value":"2451"}],"activities-steps-intraday":{"dataset":[{"time":"00:00:00","value":0},{"time":"00:01:00","value":37}],"datasetInterval":1,"datasetType":"minute"}}`)
+		default:
+			fmt.Fprint(w, `{"activities-calories":[{"dateTime":"2015-11-23","value":"2092"}],"activities-calories-intraday":{"dataset":[{"level":1,"mets":12,"time":"00:00:00","value":1.4184}],"datasetInterval":15,"datasetType":"minute"}}`)
+		}
+	}))
+
+	response, err := client.Activity.ActivityIntraday("2015-11-23", OneMinute, StepsLog, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if response.Logs[0].Value != "2451" || len(response.Intraday.DataSet) != 2 || response.Intraday.DataSet[1].Value != 37 {
+		t.Errorf("unexpected steps:%+v", response.Intraday)
+	}
+
+	response, err = client.Activity.ActivityIntraday("2015-11-23", FifteenMinutes, CaloriesLog, nil)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	dataSet := response.Intraday.DataSet[0]
+	if dataSet.Value != 1.4184 || dataSet.Level != 1 || dataSet.Mets != 12 || response.Intraday.DataSetInterval != 15 {
+		t.Errorf("unexpected calories:%+v", dataSet)
+	}
+}
+
+// rewriteTransport send every request to test server
+type rewriteTransport struct {
+	serverURL *url.URL
+}
+
+func (t *rewriteTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	request.URL.Scheme = t.serverURL.Scheme
+	request.URL.Host = t.serverURL.Host
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	serverURL, _ := url.Parse(server.URL)
+	fitbit := &Fitbit{config: &oauth2.Config{}, token: &oauth2.Token{AccessToken: "test"}}
+	client, _ := fitbit.Client()
+	client.httpClient = &http.Client{Transport: &rewriteTransport{serverURL: serverURL}}
+	return client
+}
+
+func TestSleepLogIterator(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprint(w, `{"sleep":[{"logId":1},{"logId":2}],"pagination":{"next":"https://api.fitbit.com/1.2/user/-/sleep/list.json?beforeDate=2017-03-27&sort=desc&limit=2&offset=2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"sleep":[{"logId":3}],"pagination":{"next":""}}`)
+	}))
+
+	var logIDs []uint64
+	it := client.Sleep.LogIterator(&ListParams{BeforeDate: "2017-03-27", Limit: 2})
+	for it.Next() {
+		logIDs = append(logIDs, it.Log().LogID)
+	}
+	if err := it.Err(); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(logIDs) != 3 || logIDs[2] != 3 {
+		t.Errorf("unexpected logs:%v", logIDs)
+	}
+}
+
+func TestBodyWeightLogUnitSystem(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Language") != "en_US" {
+			fmt.Fprint(w, `{"weight":[{"logId":1,"weight":60.0}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"weight":[{"logId":1,"weight":132.3}]}`)
+	}))
+	client.SetUnitSystem(UnitSystemUS)
+
+	response, err := client.Body.WeightLog("2015-11-23")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if response.Weight[0].Weight != 132.3 {
+		t.Errorf("unexpected weight:%f", response.Weight[0].Weight)
+	}
+}
+
+func TestCreateFoodLogParamsValues(t *testing.T) {
+	params := &CreateFoodLogParams{
+		FoodName:  "Onigiri",
+		Calories:  180,
+		Nutrients: &CustomFoodNutrients{TotalCarbohydrate: 39.5, Protein: 3},
+		MealType:  Lunch,
+		UnitID:    304,
+		Amount:    1,
+		Date:      "2015-11-23",
+	}
+	values, err := params.values()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if values.Get("foodId") != "" || values.Get("calories") != "180" || values.Get("totalCarbohydrate") != "39.5" || values.Get("mealTypeId") != "3" {
+		t.Errorf("unexpected values:%v", values)
+	}
+	if _, ok := values["totalFat"]; ok {
+		t.Errorf("zero nutrient is sent:%v", values)
+	}
+}
+
+func TestGetRecentFoods(t *testing.T) {
+	client, err := Prepare()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	response, err := client.Food.GetRecentFoods()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, recentFood := range response {
+		fmt.Println(recentFood.Name, recentFood.Unit.Name)
+	}
+}
+
+func TestCreateWaterLogUnit(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.PostForm.Get("unit") != "fl oz" || r.PostForm.Get("amount") != "16.9" {
+			w.WriteHeader(400)
+			fmt.Fprint(w, `{"errors":[{"errorType":"validation","fieldName":"unit","message":"invalid unit"}]}`)
+			return
+		}
+		w.WriteHeader(201)
+		fmt.Fprint(w, `{"waterLog":{"amount":16.9,"logId":1}}`)
+	}))
+	client.SetUnitSystem(UnitSystemUS)
+
+	response, err := client.Water.CreateWaterLog(WaterVolume{Amount: 16.9}, "2015-11-23")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if response.WaterLog.Unit != FluidOunce {
+		t.Errorf("unexpected unit:%s", response.WaterLog.Unit)
+	}
+}
+
+func TestCalculateEnergyBalance(t *testing.T) {
+	caloriesIn := []*ActivitiesLog{{DateTime: "2015-11-21", Value: "2100"}, {DateTime: "2015-11-22", Value: "1800"}}
+	caloriesOut := []*ActivitiesLog{{DateTime: "2015-11-22", Value: "2300"}, {DateTime: "2015-11-21", Value: "2000"}}
+	balances, err := CalculateEnergyBalance(caloriesIn, caloriesOut)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(balances) != 2 || balances[0].Balance() != 100 || balances[1].Balance() != -500 {
+		t.Errorf("unexpected balances:%v", balances)
+	}
+}
+
+func TestWeekdaySet(t *testing.T) {
+	alarm := &Alarm{}
+	if err := json.Unmarshal([]byte(`{"alarmId":1,"time":"07:15-08:00","weekDays":["MONDAY","FRIDAY"]}`), alarm); err != nil {
+		t.Error(err)
+		return
+	}
+	if !alarm.WeekDays.Has(time.Monday) || !alarm.WeekDays.Has(time.Friday) || alarm.WeekDays.Has(time.Sunday) {
+		t.Errorf("unexpected weekdays:%v", alarm.WeekDays.Days())
+	}
+	if alarm.WeekDays.String() != "MONDAY,FRIDAY" {
+		t.Errorf("unexpected weekdays:%s", alarm.WeekDays.String())
+	}
+}
+
+func TestUpdateProfileParams(t *testing.T) {
+	params := &UpdateProfileParams{WeightUnit: "en_US", WaterUnit: "METRIC", ClockTimeDisplayFormat: "24hour"}
+	if encoded := params.values().Encode(); encoded != "clockTimeDisplayFormat=24hour&waterUnit=METRIC&weightUnit=en_US" {
+		t.Errorf("unexpected values:%s", encoded)
+	}
+}
+
+func TestLoadProfileWithoutUser(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+
+	if _, err := client.LoadProfile(); err == nil {
+		t.Error("empty profile is accepted")
+	}
+	if client.Profile() != nil {
+		t.Error("empty profile is cached")
+	}
+	if _, err := client.Today(); err == nil {
+		t.Error("today is returned without profile")
+	}
+}
+
+func TestUserProfileLocation(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"user":{"displayName":"stk","timezone":"Asia/Tokyo","offsetFromUTCMillis":32400000}}`)
+	}))
+
+	profile, err := client.LoadProfile()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if _, offset := time.Now().In(profile.Location()).Zone(); offset != 9*60*60 {
+		t.Errorf("unexpected offset:%d", offset)
+	}
+	if location, err := client.location(); err != nil || location.String() != "Asia/Tokyo" {
+		t.Errorf("unexpected location:%s %v", location, err)
+	}
+	today, err := client.Today()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if today != time.Now().In(profile.Location()).Format("2006-01-02") {
+		t.Errorf("unexpected today:%s", today)
+	}
+}
+
+func TestUnmarshalLeaderboard(t *testing.T) {
+	responseByteArray := []byte(`{"data":[{"type":"ranked-user","id":"2ABCDE","attributes":{"step-rank":1,"step-summary":84543},"relationships":{"user":{"data":{"type":"person","id":"2ABCDE"}}}}],"included":[{"type":"person","id":"2ABCDE","attributes":{"name":"Friend A","friend":true,"child":false}}]}`)
+	entries, err := unmarshalLeaderboard(responseByteArray)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(entries) != 1 || entries[0].StepRank != 1 || entries[0].StepSummary != 84543 || entries[0].User.Name != "Friend A" {
+		t.Errorf("unexpected entries:%+v", entries)
+	}
+}
+
+func TestGetBadges(t *testing.T) {
+	client, err := Prepare()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	response, err := client.User.GetBadges()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, badge := range response {
+		fmt.Println(badge.BadgeType, badge.Name, badge.DateTime, badge.TimesAchieved)
+	}
+}
+
+func TestGetBadgesDecode(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/1/user/-/badges.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"badges":[{"badgeGradientEndColor":"00D3D6","badgeGradientStartColor":"007273","badgeType":"DAILY_STEPS","category":"Daily Steps","dateTime":"2016-07-17","description":"10,000 steps in a day","earnedMessage":"Congrats on earning your first Sneakers badge!","encodedId":"228TQ4","image100px":"https://static0.fitbit.com/images/badges_new/100px/badge_daily_steps10k.png","image50px":"https://static0.fitbit.com/images/badges_new/badge_daily_steps10k.png","name":"Sneakers (10,000 steps in a day)","shareImage640px":"https://static0.fitbit.com/images/badges_new/386px/shareLocalized/en_US/badge_daily_steps10k.png","shortName":"Sneakers","timesAchieved":123,"unit":"STEPS","value":10000}]}`)
+	}))
+
+	badges, err := client.User.GetBadges()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(badges) != 1 {
+		t.Errorf("unexpected badges:%+v", badges)
+		return
+	}
+	badge := badges[0]
+	if badge.BadgeType != DailyStepsBadge || badge.EncodedID != "228TQ4" || badge.DateTime != "2016-07-17" || badge.ShortName != "Sneakers" {
+		t.Errorf("unexpected badge:%+v", badge)
+	}
+	if badge.TimesAchieved != 123 || badge.Value != 10000 || badge.Unit != "STEPS" || badge.BadgeGradientEndColor != "00D3D6" {
+		t.Errorf("unexpected badge:%+v", badge)
+	}
+	if badge.Image50px == "" || badge.Image100px == "" || badge.ShareImage640px == "" {
+		t.Errorf("unexpected badge images:%+v", badge)
+	}
+}
+
+func TestCreateSubscription(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/1/user/-/activities/apiSubscriptions/320.json" || r.Header.Get("X-Fitbit-Subscriber-Id") != "1" {
+			w.WriteHeader(404)
+			return
+		}
+		w.WriteHeader(201)
+		fmt.Fprint(w, `{"collectionType":"activities","ownerId":"227YZL","ownerType":"user","subscriberId":"1","subscriptionId":"320"}`)
+	}))
+
+	subscription, err := client.Subscriptions.Create(ActivitiesCollection, "320", "1")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if subscription.CollectionType != ActivitiesCollection || subscription.OwnerID != "227YZL" {
+		t.Errorf("unexpected subscription:%+v", subscription)
+	}
+}
+
+func TestWebhookHandler(t *testing.T) {
+	if _, err := NewWebhookHandler(&oauth2.Config{ClientSecret: "secret"}, ""); err == nil {
+		t.Error("empty verification code is accepted")
+	}
+	handler, err := NewWebhookHandler(&oauth2.Config{ClientSecret: "secret"}, "code")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	received := make(chan *Notification, 1)
+	panicked := make(chan interface{}, 1)
+	handler.PanicHandler = func(notification *Notification, recovered interface{}) {
+		panicked <- recovered
+	}
+	handler.Handle(SleepCollection, func(notification *Notification) {
+		panic("callback failed")
+	})
+	// registering in callback must not deadlock
+	handler.Handle(SleepCollection, func(notification *Notification) {
+		handler.Handle(BodyCollection, func(notification *Notification) {})
+	})
+	handler.Handle(SleepCollection, func(notification *Notification) {
+		received <- notification
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/webhook?verify=code", nil))
+	if recorder.Code != 204 {
+		t.Errorf("verification failed. status code:%d", recorder.Code)
+	}
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/webhook?verify=wrong", nil))
+	if recorder.Code != 404 {
+		t.Errorf("wrong code is accepted. status code:%d", recorder.Code)
+	}
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/webhook", nil))
+	if recorder.Code != 404 {
+		t.Errorf("missing code is accepted. status code:%d", recorder.Code)
+	}
+
+	body := `[{"collectionType":"sleep","date":"2015-11-23","ownerId":"227YZL","ownerType":"user","subscriptionId":"320"}]`
+	mac := hmac.New(sha1.New, []byte("secret&"))
+	mac.Write([]byte(body))
+	request := httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+	request.Header.Set("X-Fitbit-Signature", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != 204 {
+		t.Errorf("notification failed. status code:%d", recorder.Code)
+		return
+	}
+	select {
+	case notification := <-received:
+		if notification.OwnerID != "227YZL" || notification.Date != "2015-11-23" {
+			t.Errorf("unexpected notification:%+v", notification)
+		}
+	case <-time.After(time.Second):
+		t.Error("callback isn't called")
+	}
+	if recovered := <-panicked; recovered != "callback failed" {
+		t.Errorf("unexpected panic:%v", recovered)
+	}
+
+	request = httptest.NewRequest("POST", "/webhook", strings.NewReader(body))
+	request.Header.Set("X-Fitbit-Signature", "invalid")
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != 404 {
+		t.Errorf("invalid signature is accepted. status code:%d", recorder.Code)
+	}
+
+	request = httptest.NewRequest("POST", "/webhook", strings.NewReader(strings.Repeat(" ", int(maxNotificationBodySize)+1)))
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	if recorder.Code != 400 {
+		t.Errorf("too large body is accepted. status code:%d", recorder.Code)
+	}
+}
+
+func TestHRVSummary(t *testing.T) {
+	client, err := Prepare()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	response, err := client.HRV.SummaryByDateRange("2015-11-20", "2015-11-23")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, hrv := range response.HRV {
+		fmt.Println(hrv.DateTime, hrv.Value.DailyRmssd, hrv.Value.DeepRmssd)
+	}
+}
+
+func TestSpO2Decode(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/1/user/-/spo2/date/2021-10-04.json":
+			fmt.Fprint(w, `{"dateTime":"2021-10-04","value":{"avg":97.5,"min":94.0,"max":100.0}}`)
+		case "/1/user/-/spo2/date/2021-10-01/2021-10-04.json":
+			fmt.Fprint(w, `[{"dateTime":"2021-10-01","value":{"avg":95.7,"min":90.2,"max":99.9}},{"dateTime":"2021-10-04","value":{"avg":97.5,"min":94.0,"max":100.0}}]`)
+		case "/1/user/-/spo2/date/2021-10-04/all.json":
+			fmt.Fprint(w, `{"dateTime":"2021-10-04","minutes":[{"value":95.7,"minute":"2021-10-04T00:00:00"},{"value":96.4,"minute":"2021-10-04T00:01:00"}]}`)
+		case "/1/user/-/spo2/date/2021-10-01/2021-10-04/all.json":
+			fmt.Fprint(w, `[{"dateTime":"2021-10-01","minutes":[{"value":95.7,"minute":"2021-10-01T00:00:00"}]}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	summary, err := client.SpO2.Summary("2021-10-04")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if summary.DateTime != "2021-10-04" || summary.Value.Avg != 97.5 || summary.Value.Min != 94 || summary.Value.Max != 100 {
+		t.Errorf("unexpected summary:%+v", summary)
+	}
+	summaries, err := client.SpO2.SummaryByDateRange("2021-10-01", "2021-10-04")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(summaries) != 2 || summaries[0].Value.Min != 90.2 {
+		t.Errorf("unexpected summaries:%+v", summaries)
+	}
+	intraday, err := client.SpO2.Intraday("2021-10-04")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(intraday.Minutes) != 2 || intraday.Minutes[1].Minute != "2021-10-04T00:01:00" || intraday.Minutes[1].Value != 96.4 {
+		t.Errorf("unexpected intraday:%+v", intraday)
+	}
+	intradays, err := client.SpO2.IntradayByDateRange("2021-10-01", "2021-10-04")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(intradays) != 1 || intradays[0].DateTime != "2021-10-01" || len(intradays[0].Minutes) != 1 {
+		t.Errorf("unexpected intradays:%+v", intradays)
+	}
+}
+
+func TestBreathingRateDecode(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/1/user/-/br/date/2021-10-25.json":
+			fmt.Fprint(w, `{"br":[{"value":{"breathingRate":17.8},"dateTime":"2021-10-25"}]}`)
+		case "/1/user/-/br/date/2021-10-25/all.json":
+			fmt.Fprint(w, `{"br":[{"value":{"deepSleepSummary":{"breathingRate":16.8},"remSleepSummary":{"breathingRate":0.0},"fullSleepSummary":{"breathingRate":17.8},"lightSleepSummary":{"breathingRate":16.8}},"dateTime":"2021-10-25"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	summary, err := client.BreathingRate.Summary("2021-10-25")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(summary.BreathingRate) != 1 || summary.BreathingRate[0].Value.BreathingRate != 17.8 {
+		t.Errorf("unexpected summary:%+v", summary.BreathingRate)
+	}
+	intraday, err := client.BreathingRate.Intraday("2021-10-25")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(intraday.BreathingRate) != 1 {
+		t.Errorf("unexpected intraday:%+v", intraday.BreathingRate)
+		return
+	}
+	stages := intraday.BreathingRate[0].Value
+	if stages.DeepSleepSummary.BreathingRate != 16.8 || stages.FullSleepSummary.BreathingRate != 17.8 || stages.LightSleepSummary.BreathingRate != 16.8 || stages.REMSleepSummary == nil {
+		t.Errorf("unexpected sleep stages:%+v", stages)
+	}
+}
+
+func TestHRVDecode(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/1/user/-/hrv/date/2021-10-25.json":
+			fmt.Fprint(w, `{"hrv":[{"value":{"dailyRmssd":34.938,"deepRmssd":31.567},"dateTime":"2021-10-25"}]}`)
+		case "/1/user/-/hrv/date/2021-10-25/all.json":
+			fmt.Fprint(w, `{"hrv":[{"minutes":[{"minute":"2021-10-25T09:10:00.000","value":{"rmssd":26.617,"coverage":0.935,"hf":113.223,"lf":168.116}}],"dateTime":"2021-10-25"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	summary, err := client.HRV.Summary("2021-10-25")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(summary.HRV) != 1 || summary.HRV[0].Value.DailyRmssd != 34.938 || summary.HRV[0].Value.DeepRmssd != 31.567 {
+		t.Errorf("unexpected summary:%+v", summary.HRV)
+	}
+	intraday, err := client.HRV.Intraday("2021-10-25")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(intraday.HRV) != 1 || len(intraday.HRV[0].Minutes) != 1 {
+		t.Errorf("unexpected intraday:%+v", intraday.HRV)
+		return
+	}
+	minute := intraday.HRV[0].Minutes[0]
+	if minute.Minute != "2021-10-25T09:10:00.000" || minute.Value.Rmssd != 26.617 || minute.Value.Coverage != 0.935 || minute.Value.HF != 113.223 || minute.Value.LF != 168.116 {
+		t.Errorf("unexpected minute:%+v", minute)
+	}
+}
+
+func TestSkinTemperature(t *testing.T) {
+	client, err := Prepare()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	response, err := client.Temperature.SkinByDateRange("2015-11-20", "2015-11-23")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for _, temperature := range response.TempSkin {
+		fmt.Println(temperature.DateTime, temperature.LogType, temperature.Value.NightlyRelative)
+	}
+}
+
+func TestTemperatureDecode(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/1/user/-/temp/skin/date/2021-10-04.json":
+			fmt.Fprint(w, `{"tempSkin":[{"dateTime":"2021-10-04","value":{"nightlyRelative":-0.31},"logType":"dedicated_temp_sensor"}]}`)
+		case "/1/user/-/temp/core/date/2021-10-01/2021-10-04.json":
+			fmt.Fprint(w, `{"tempCore":[{"dateTime":"2021-10-01T08:00:00","value":37.1},{"dateTime":"2021-10-04T21:30:00","value":36.8}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	skin, err := client.Temperature.Skin("2021-10-04")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(skin.TempSkin) != 1 {
+		t.Errorf("unexpected skin temperature:%+v", skin.TempSkin)
+		return
+	}
+	if skin.TempSkin[0].DateTime != "2021-10-04" || skin.TempSkin[0].LogType != DedicatedTempSensor || skin.TempSkin[0].Value.NightlyRelative != -0.31 {
+		t.Errorf("unexpected skin temperature:%+v", skin.TempSkin[0])
+	}
+	core, err := client.Temperature.CoreByDateRange("2021-10-01", "2021-10-04")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if len(core.TempCore) != 2 || core.TempCore[1].DateTime != "2021-10-04T21:30:00" || core.TempCore[1].Value != 36.8 {
+		t.Errorf("unexpected core temperature:%+v", core.TempCore)
+	}
+}
+
+func TestVO2Max(t *testing.T) {
+	response := &CardioScoreResponse{}
+	if err := json.Unmarshal([]byte(`{"cardioScore":[{"dateTime":"2015-11-22","value":{"vo2Max":"44-48"}},{"dateTime":"2015-11-23","value":{"vo2Max":46.5}}]}`), response); err != nil {
+		t.Error(err)
+		return
+	}
+	ranged := response.CardioScore[0].Value.VO2Max
+	if !ranged.IsRange() || ranged.Min != 44 || ranged.Max != 48 {
+		t.Errorf("unexpected vo2Max:%+v", ranged)
+	}
+	single := response.CardioScore[1].Value.VO2Max
+	if single.IsRange() || single.Min != 46.5 {
+		t.Errorf("unexpected vo2Max:%+v", single)
+	}
+}
+
+func TestECGLogIterator(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprint(w, `{"ecgReadings":[{"startTime":"2022-09-28T17:12:30.222","resultClassification":"Normal Sinus Rhythm","waveformSamples":[10922,-5461],"samplingFrequencyHz":"250","scalingFactor":10922}],"pagination":{"next":"https://api.fitbit.com/1/user/-/ecg/list.json?beforeDate=2022-09-29&sort=desc&limit=1&offset=1"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"ecgReadings":[{"startTime":"2022-09-27T08:00:00.000","resultClassification":"Atrial Fibrillation"}],"pagination":{"next":""}}`)
+	}))
+
+	var readings []*ECGReading
+	it := client.ECG.LogIterator(&ListParams{BeforeDate: "2022-09-29", Limit: 1})
+	for it.Next() {
+		readings = append(readings, it.Reading())
+	}
+	if err := it.Err(); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(readings) != 2 || readings[1].ResultClassification != AtrialFibrillation {
+		t.Errorf("unexpected readings:%v", readings)
+		return
+	}
+	millivolts := readings[0].Millivolts()
+	if readings[0].SamplingFrequency() != 250 || len(millivolts) != 2 || millivolts[0] != 1 || millivolts[1] != -0.5 {
+		t.Errorf("unexpected waveform:%v", millivolts)
+	}
+}
+
+func TestListDefaultLimit(t *testing.T) {
+	var limits []string
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limits = append(limits, r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{}`)
+	}))
+
+	params := &ListParams{BeforeDate: "2022-09-29"}
+	if _, err := client.ECG.LogList(params); err != nil {
+		t.Error(err)
+		return
+	}
+	for it := client.IRN.AlertIterator(params); it.Next(); {
+	}
+	for it := client.Sleep.LogIterator(params); it.Next(); {
+	}
+	if strings.Join(limits, ",") != "10,10,100" {
+		t.Errorf("unexpected limits:%v", limits)
+	}
+
+	if _, err := client.ECG.LogList(&ListParams{BeforeDate: "2022-09-29", Limit: 11}); err == nil {
+		t.Error("out of range limit is accepted")
+	}
+	if it := client.IRN.AlertIterator(&ListParams{BeforeDate: "2022-09-29", Limit: 11}); it.Next() || it.Err() == nil {
+		t.Error("out of range limit is accepted")
+	}
+	if len(limits) != 3 {
+		t.Errorf("request with out of range limit is sent:%v", limits)
+	}
+}
+
+func TestIRNAlertIterator(t *testing.T) {
+	client := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprint(w, `{"alerts":[{"alertTime":"2022-09-28T17:12:30.000","tachograms":[{"startTime":"2022-09-28T16:12:30.000","data":[800,780]}]}],"pagination":{"next":"https://api.fitbit.com/1/user/-/irn/alerts/list.json?afterDate=2022-09-01&sort=asc&limit=1&offset=1"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"alerts":[],"pagination":{"next":""}}`)
+	}))
+
+	var alerts []*IRNAlert
+	it := client.IRN.AlertIterator(&ListParams{AfterDate: "2022-09-01", Limit: 1})
+	for it.Next() {
+		alerts = append(alerts, it.Alert())
+	}
+	if err := it.Err(); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(alerts) != 1 || len(alerts[0].Tachograms) != 1 || alerts[0].Tachograms[0].Data[1] != 780 {
+		t.Errorf("unexpected alerts:%v", alerts)
+	}
+}
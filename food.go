@@ -0,0 +1,273 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type MealType uint64
+
+const (
+	Breakfast      MealType = 1
+	MorningSnack   MealType = 2
+	Lunch          MealType = 3
+	AfternoonSnack MealType = 4
+	Dinner         MealType = 5
+	Anytime        MealType = 7
+
+	FoodLogURL       string = "https://api.fitbit.com/1/user/%s/foods/log/date/%s.json"
+	CreateFoodLogURL string = "https://api.fitbit.com/1/user/-/foods/log.json"
+	FoodLogEntryURL  string = "https://api.fitbit.com/1/user/-/foods/log/%d.json"
+	SearchFoodsURL   string = "https://api.fitbit.com/1/foods/search.json"
+)
+
+// Food fitbit food api
+type Food struct {
+	c *Client
+}
+
+// FoodUnit unit of food amount
+type FoodUnit struct {
+	ID     uint64 `json:"id"`
+	Name   string `json:"name"`
+	Plural string `json:"plural"`
+}
+
+// LoggedFood food of food log
+type LoggedFood struct {
+	AccessLevel string    `json:"accessLevel"`
+	Amount      float64   `json:"amount"`
+	Brand       string    `json:"brand"`
+	Calories    uint64    `json:"calories"`
+	FoodID      uint64    `json:"foodId"`
+	Locale      string    `json:"locale"`
+	MealTypeID  MealType  `json:"mealTypeId"`
+	Name        string    `json:"name"`
+	Unit        *FoodUnit `json:"unit"`
+	Units       []uint64  `json:"units"`
+}
+
+// NutritionalValues nutritional values of food log
+type NutritionalValues struct {
+	Calories float64 `json:"calories"`
+	Carbs    float64 `json:"carbs"`
+	Fat      float64 `json:"fat"`
+	Fiber    float64 `json:"fiber"`
+	Protein  float64 `json:"protein"`
+	Sodium   float64 `json:"sodium"`
+}
+
+// FoodLog fitbit food log
+type FoodLog struct {
+	IsFavorite        bool               `json:"isFavorite"`
+	LogDate           string             `json:"logDate"`
+	LogID             uint64             `json:"logId"`
+	LoggedFood        *LoggedFood        `json:"loggedFood"`
+	NutritionalValues *NutritionalValues `json:"nutritionalValues"`
+}
+
+// FoodLogSummary nutritional summary of a day
+type FoodLogSummary struct {
+	Calories float64 `json:"calories"`
+	Carbs    float64 `json:"carbs"`
+	Fat      float64 `json:"fat"`
+	Fiber    float64 `json:"fiber"`
+	Protein  float64 `json:"protein"`
+	Sodium   float64 `json:"sodium"`
+	Water    float64 `json:"water"`
+}
+
+// FoodLogGoals food goals of a day
+type FoodLogGoals struct {
+	Calories uint64 `json:"calories"`
+}
+
+type FoodLogResponse struct {
+	Foods   []*FoodLog      `json:"foods"`
+	Goals   *FoodLogGoals   `json:"goals"`
+	Summary *FoodLogSummary `json:"summary"`
+}
+
+type FoodLogEntryResponse struct {
+	FoodLog *FoodLog `json:"foodLog"`
+}
+
+// CustomFoodNutrients nutrients of custom food. zero value isn't sent
+type CustomFoodNutrients struct {
+	TotalFat          float64
+	SaturatedFat      float64
+	TransFat          float64
+	Cholesterol       float64
+	Sodium            float64
+	Potassium         float64
+	TotalCarbohydrate float64
+	DietaryFiber      float64
+	Sugars            float64
+	Protein           float64
+}
+
+func (n *CustomFoodNutrients) addValues(values url.Values) {
+	nutrients := []struct {
+		key   string
+		value float64
+	}{
+		{"totalFat", n.TotalFat},
+		{"saturatedFat", n.SaturatedFat},
+		{"transFat", n.TransFat},
+		{"cholesterol", n.Cholesterol},
+		{"sodium", n.Sodium},
+		{"potassium", n.Potassium},
+		{"totalCarbohydrate", n.TotalCarbohydrate},
+		{"dietaryFiber", n.DietaryFiber},
+		{"sugars", n.Sugars},
+		{"protein", n.Protein},
+	}
+	for _, nutrient := range nutrients {
+		if nutrient.value > 0 {
+			values.Add(nutrient.key, strconv.FormatFloat(nutrient.value, 'f', -1, 64))
+		}
+	}
+}
+
+// CreateFoodLogParams parameters of CreateFoodLog. set FoodID to log food of
+// database, or FoodName and Calories to log custom entry
+type CreateFoodLogParams struct {
+	FoodID    uint64
+	FoodName  string
+	BrandName string
+	Calories  uint64
+	Nutrients *CustomFoodNutrients
+
+	MealType MealType
+	UnitID   uint64
+	Amount   float64
+	Date     string
+	Favorite bool
+}
+
+func (p *CreateFoodLogParams) values() (url.Values, error) {
+	values := url.Values{}
+	switch {
+	case p.FoodID != 0:
+		values.Add("foodId", strconv.FormatUint(p.FoodID, 10))
+	case p.FoodName != "":
+		values.Add("foodName", p.FoodName)
+		if p.BrandName != "" {
+			values.Add("brandName", p.BrandName)
+		}
+		values.Add("calories", strconv.FormatUint(p.Calories, 10))
+		if p.Nutrients != nil {
+			p.Nutrients.addValues(values)
+		}
+	default:
+		return nil, errors.New("foodId or foodName is required")
+	}
+	values.Add("mealTypeId", strconv.FormatUint(uint64(p.MealType), 10))
+	values.Add("unitId", strconv.FormatUint(p.UnitID, 10))
+	values.Add("amount", strconv.FormatFloat(p.Amount, 'f', 2, 64))
+	values.Add("date", p.Date)
+	if p.Favorite {
+		values.Add("favorite", "true")
+	}
+	return values, nil
+}
+
+// EditFoodLogParams parameters of EditFoodLog
+type EditFoodLogParams struct {
+	MealType MealType
+	UnitID   uint64
+	Amount   float64
+	// Calories only for custom entry
+	Calories uint64
+}
+
+// SearchedFood food of food database
+type SearchedFood struct {
+	AccessLevel        string    `json:"accessLevel"`
+	Brand              string    `json:"brand"`
+	Calories           uint64    `json:"calories"`
+	DefaultServingSize float64   `json:"defaultServingSize"`
+	DefaultUnit        *FoodUnit `json:"defaultUnit"`
+	FoodID             uint64    `json:"foodId"`
+	IsGeneric          bool      `json:"isGeneric"`
+	Locale             string    `json:"locale"`
+	Name               string    `json:"name"`
+	Units              []uint64  `json:"units"`
+}
+
+type SearchFoodsResponse struct {
+	Foods []*SearchedFood `json:"foods"`
+}
+
+// FoodLogByID food logs of date
+func (f *Food) FoodLogByID(userID string, date string) (*FoodLogResponse, error) {
+	responseByteArray, err := f.c.Get(fmt.Sprintf(FoodLogURL, userID, date))
+	if err != nil {
+		return nil, err
+	}
+	response := &FoodLogResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (f *Food) FoodLog(date string) (*FoodLogResponse, error) {
+	return f.FoodLogByID("-", date)
+}
+
+func (f *Food) postFoodLog(url string, values url.Values) (*FoodLogEntryResponse, error) {
+	responseByteArray, err := f.c.PostForm(url, values)
+	if err != nil {
+		return nil, err
+	}
+	response := &FoodLogEntryResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CreateFoodLog log food
+func (f *Food) CreateFoodLog(params *CreateFoodLogParams) (*FoodLogEntryResponse, error) {
+	values, err := params.values()
+	if err != nil {
+		return nil, err
+	}
+	return f.postFoodLog(CreateFoodLogURL, values)
+}
+
+// EditFoodLog edit food log
+func (f *Food) EditFoodLog(logID uint64, params *EditFoodLogParams) (*FoodLogEntryResponse, error) {
+	values := url.Values{}
+	values.Add("mealTypeId", strconv.FormatUint(uint64(params.MealType), 10))
+	values.Add("unitId", strconv.FormatUint(params.UnitID, 10))
+	values.Add("amount", strconv.FormatFloat(params.Amount, 'f', 2, 64))
+	if params.Calories > 0 {
+		values.Add("calories", strconv.FormatUint(params.Calories, 10))
+	}
+	return f.postFoodLog(fmt.Sprintf(FoodLogEntryURL, logID), values)
+}
+
+// DeleteFoodLog delete food log
+func (f *Food) DeleteFoodLog(logID uint64) error {
+	return f.c.Delete(fmt.Sprintf(FoodLogEntryURL, logID))
+}
+
+// SearchFoods search food database
+func (f *Food) SearchFoods(query string) (*SearchFoodsResponse, error) {
+	values := url.Values{}
+	values.Add("query", query)
+	responseByteArray, err := f.c.Get(SearchFoodsURL + "?" + values.Encode())
+	if err != nil {
+		return nil, err
+	}
+	response := &SearchFoodsResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
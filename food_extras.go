@@ -0,0 +1,259 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type FoodFormType string
+
+const (
+	FoodLiquid FoodFormType = "LIQUID"
+	FoodDry    FoodFormType = "DRY"
+
+	CreateFoodURL           string = "https://api.fitbit.com/1/user/-/foods.json"
+	DeleteFoodURL           string = "https://api.fitbit.com/1/user/-/foods/%d.json"
+	MealsURL                string = "https://api.fitbit.com/1/user/-/meals.json"
+	MealURL                 string = "https://api.fitbit.com/1/user/-/meals/%d.json"
+	GetFavoriteFoodsURL     string = "https://api.fitbit.com/1/user/%s/foods/log/favorite.json"
+	FavoriteFoodResourceURL string = "https://api.fitbit.com/1/user/-/foods/log/favorite/%d.json"
+	GetFrequentFoodsURL     string = "https://api.fitbit.com/1/user/-/foods/log/frequent.json"
+	GetRecentFoodsURL       string = "https://api.fitbit.com/1/user/-/foods/log/recent.json"
+	GetFoodUnitsURL         string = "https://api.fitbit.com/1/foods/units.json"
+	GetFoodLocalesURL       string = "https://api.fitbit.com/1/foods/locales.json"
+)
+
+// CustomFood private custom food
+type CustomFood struct {
+	AccessLevel        string    `json:"accessLevel"`
+	Brand              string    `json:"brand"`
+	Calories           uint64    `json:"calories"`
+	DefaultServingSize float64   `json:"defaultServingSize"`
+	DefaultUnit        *FoodUnit `json:"defaultUnit"`
+	FoodID             uint64    `json:"foodId"`
+	IsGeneric          bool      `json:"isGeneric"`
+	Locale             string    `json:"locale"`
+	Name               string    `json:"name"`
+	Units              []uint64  `json:"units"`
+}
+
+type CustomFoodResponse struct {
+	Food *CustomFood `json:"food"`
+}
+
+// CreateFoodParams parameters of CreateFood
+type CreateFoodParams struct {
+	Name                         string
+	Description                  string
+	DefaultFoodMeasurementUnitID uint64
+	DefaultServingSize           float64
+	Calories                     uint64
+	FormType                     FoodFormType
+	Nutrients                    *CustomFoodNutrients
+}
+
+// MealFood food of meal
+type MealFood struct {
+	Amount float64 `json:"amount"`
+	FoodID uint64  `json:"foodId"`
+	UnitID uint64  `json:"unitId"`
+}
+
+// Meal fitbit meal
+type Meal struct {
+	Description string      `json:"description"`
+	ID          uint64      `json:"id"`
+	MealFoods   []*MealFood `json:"mealFoods"`
+	Name        string      `json:"name"`
+}
+
+type MealsResponse struct {
+	Meals []*Meal `json:"meals"`
+}
+
+type MealResponse struct {
+	Meal *Meal `json:"meal"`
+}
+
+// UserFood favorite, frequent or recent food
+type UserFood struct {
+	AccessLevel        string    `json:"accessLevel"`
+	Amount             float64   `json:"amount"`
+	Brand              string    `json:"brand"`
+	Calories           uint64    `json:"calories"`
+	DateLastEaten      string    `json:"dateLastEaten"`
+	DefaultServingSize float64   `json:"defaultServingSize"`
+	DefaultUnit        *FoodUnit `json:"defaultUnit"`
+	FoodID             uint64    `json:"foodId"`
+	Locale             string    `json:"locale"`
+	MealTypeID         MealType  `json:"mealTypeId"`
+	Name               string    `json:"name"`
+	Unit               *FoodUnit `json:"unit"`
+	Units              []uint64  `json:"units"`
+}
+
+// FoodLocale locale of food database
+type FoodLocale struct {
+	Barcode     bool   `json:"barcode"`
+	ImageUpload bool   `json:"imageUpload"`
+	Label       string `json:"label"`
+	Value       string `json:"value"`
+}
+
+// CreateFood create private custom food
+func (f *Food) CreateFood(params *CreateFoodParams) (*CustomFoodResponse, error) {
+	values := url.Values{}
+	values.Add("name", params.Name)
+	if params.Description != "" {
+		values.Add("description", params.Description)
+	}
+	values.Add("defaultFoodMeasurementUnitId", strconv.FormatUint(params.DefaultFoodMeasurementUnitID, 10))
+	values.Add("defaultServingSize", strconv.FormatFloat(params.DefaultServingSize, 'f', -1, 64))
+	values.Add("calories", strconv.FormatUint(params.Calories, 10))
+	if params.FormType != "" {
+		values.Add("formType", string(params.FormType))
+	}
+	if params.Nutrients != nil {
+		params.Nutrients.addValues(values)
+	}
+
+	responseByteArray, err := f.c.PostForm(CreateFoodURL, values)
+	if err != nil {
+		return nil, err
+	}
+	response := &CustomFoodResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DeleteFood delete private custom food
+func (f *Food) DeleteFood(foodID uint64) error {
+	return f.c.Delete(fmt.Sprintf(DeleteFoodURL, foodID))
+}
+
+func (f *Food) GetMeals() (*MealsResponse, error) {
+	responseByteArray, err := f.c.Get(MealsURL)
+	if err != nil {
+		return nil, err
+	}
+	response := &MealsResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (f *Food) GetMeal(mealID uint64) (*MealResponse, error) {
+	responseByteArray, err := f.c.Get(fmt.Sprintf(MealURL, mealID))
+	if err != nil {
+		return nil, err
+	}
+	response := &MealResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (f *Food) postMeal(url string, meal *Meal) (*MealResponse, error) {
+	body := map[string]interface{}{
+		"name":        meal.Name,
+		"description": meal.Description,
+		"mealFoods":   meal.MealFoods,
+	}
+	responseByteArray, err := f.c.PostJSON(url, body)
+	if err != nil {
+		return nil, err
+	}
+	response := &MealResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CreateMeal create meal. meal.ID is ignored
+func (f *Food) CreateMeal(meal *Meal) (*MealResponse, error) {
+	return f.postMeal(MealsURL, meal)
+}
+
+// UpdateMeal replace name, description and foods of meal.ID
+func (f *Food) UpdateMeal(meal *Meal) (*MealResponse, error) {
+	return f.postMeal(fmt.Sprintf(MealURL, meal.ID), meal)
+}
+
+func (f *Food) DeleteMeal(mealID uint64) error {
+	return f.c.Delete(fmt.Sprintf(MealURL, mealID))
+}
+
+func unmarshalUserFood(responseByteArray []byte) ([]UserFood, error) {
+	var response []UserFood
+	if err := json.Unmarshal(responseByteArray, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (f *Food) getUserFoods(url string) ([]UserFood, error) {
+	responseByteArray, err := f.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalUserFood(responseByteArray)
+}
+
+func (f *Food) GetFrequentFoods() ([]UserFood, error) {
+	return f.getUserFoods(GetFrequentFoodsURL)
+}
+
+func (f *Food) GetRecentFoods() ([]UserFood, error) {
+	return f.getUserFoods(GetRecentFoodsURL)
+}
+
+func (f *Food) GetFavoriteFoodsByID(userID string) ([]UserFood, error) {
+	return f.getUserFoods(fmt.Sprintf(GetFavoriteFoodsURL, userID))
+}
+
+func (f *Food) GetFavoriteFoods() ([]UserFood, error) {
+	return f.GetFavoriteFoodsByID("-")
+}
+
+func (f *Food) AddFavoriteFood(foodID uint64) error {
+	return f.c.Post(fmt.Sprintf(FavoriteFoodResourceURL, foodID))
+}
+
+func (f *Food) DeleteFavoriteFood(foodID uint64) error {
+	return f.c.Delete(fmt.Sprintf(FavoriteFoodResourceURL, foodID))
+}
+
+func (f *Food) GetFoodUnits() ([]FoodUnit, error) {
+	responseByteArray, err := f.c.Get(GetFoodUnitsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []FoodUnit
+	if err = json.Unmarshal(responseByteArray, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (f *Food) GetFoodLocales() ([]FoodLocale, error) {
+	responseByteArray, err := f.c.Get(GetFoodLocalesURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var response []FoodLocale
+	if err = json.Unmarshal(responseByteArray, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,175 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+type FoodPlanIntensity string
+
+const (
+	FoodPlanMaintenance FoodPlanIntensity = "MAINTENANCE"
+	FoodPlanEasier      FoodPlanIntensity = "EASIER"
+	FoodPlanMedium      FoodPlanIntensity = "MEDIUM"
+	FoodPlanKindaHard   FoodPlanIntensity = "KINDAHARD"
+	FoodPlanHarder      FoodPlanIntensity = "HARDER"
+
+	// CaloriesInResource fitbit caloriesIn resource path
+	CaloriesInResource string = "foods/log/caloriesIn"
+
+	FoodGoalsURL string = "https://api.fitbit.com/1/user/%s/foods/log/goal.json"
+)
+
+// FoodPlan fitbit food plan
+type FoodPlan struct {
+	EstimatedDate string            `json:"estimatedDate"`
+	Intensity     FoodPlanIntensity `json:"intensity"`
+	Personalized  bool              `json:"personalized"`
+}
+
+type FoodGoalsResponse struct {
+	FoodPlan *FoodPlan     `json:"foodPlan"`
+	Goals    *FoodLogGoals `json:"goals"`
+}
+
+type CaloriesInTimeSeriesResponse struct {
+	CaloriesIn []*ActivitiesLog `json:"foods-log-caloriesIn"`
+}
+
+// EnergyBalance calories in and out of a day
+type EnergyBalance struct {
+	Date        string
+	CaloriesIn  float64
+	CaloriesOut float64
+}
+
+// Balance calories in minus calories out
+func (e *EnergyBalance) Balance() float64 {
+	return e.CaloriesIn - e.CaloriesOut
+}
+
+// GetFoodGoalsByID calorie goal and food plan
+func (f *Food) GetFoodGoalsByID(userID string) (*FoodGoalsResponse, error) {
+	responseByteArray, err := f.c.Get(fmt.Sprintf(FoodGoalsURL, userID))
+	if err != nil {
+		return nil, err
+	}
+	response := &FoodGoalsResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (f *Food) GetFoodGoals() (*FoodGoalsResponse, error) {
+	return f.GetFoodGoalsByID("-")
+}
+
+// UpdateFoodGoals update calorie goal, or food plan intensity when calories is 0
+func (f *Food) UpdateFoodGoals(calories uint64, intensity FoodPlanIntensity, personalized bool) (*FoodGoalsResponse, error) {
+	values := url.Values{}
+	switch {
+	case calories > 0:
+		values.Add("calories", strconv.FormatUint(calories, 10))
+	case intensity != "":
+		values.Add("intensity", string(intensity))
+		values.Add("personalized", strconv.FormatBool(personalized))
+	default:
+		return nil, errors.New("calories or intensity is required")
+	}
+	responseByteArray, err := f.c.PostForm(fmt.Sprintf(FoodGoalsURL, "-"), values)
+	if err != nil {
+		return nil, err
+	}
+	response := &FoodGoalsResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (f *Food) getCaloriesInTimeSeries(url string) (*CaloriesInTimeSeriesResponse, error) {
+	responseByteArray, err := f.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &CaloriesInTimeSeriesResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CaloriesInTimeSeriesByID calories in of period ending at date
+func (f *Food) CaloriesInTimeSeriesByID(userID string, date string, period Period) (*CaloriesInTimeSeriesResponse, error) {
+	return f.getCaloriesInTimeSeries(fmt.Sprintf(ActivityTimeSeriesURL, userID, CaloriesInResource, date, string(period)))
+}
+
+func (f *Food) CaloriesInTimeSeries(date string, period Period) (*CaloriesInTimeSeriesResponse, error) {
+	return f.CaloriesInTimeSeriesByID("-", date, period)
+}
+
+// CaloriesInTimeSeriesByDateRangeByID calories in between startDate and endDate
+func (f *Food) CaloriesInTimeSeriesByDateRangeByID(userID string, startDate string, endDate string) (*CaloriesInTimeSeriesResponse, error) {
+	return f.getCaloriesInTimeSeries(fmt.Sprintf(ActivityTimeSeriesURL, userID, CaloriesInResource, startDate, endDate))
+}
+
+func (f *Food) CaloriesInTimeSeriesByDateRange(startDate string, endDate string) (*CaloriesInTimeSeriesResponse, error) {
+	return f.CaloriesInTimeSeriesByDateRangeByID("-", startDate, endDate)
+}
+
+// EnergyBalanceByDateRangeByID daily calories in from foods/log/caloriesIn and
+// calories out from activities/calories between startDate and endDate
+func (f *Food) EnergyBalanceByDateRangeByID(userID string, startDate string, endDate string) ([]*EnergyBalance, error) {
+	caloriesIn, err := f.CaloriesInTimeSeriesByDateRangeByID(userID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	caloriesOut, err := f.c.Activity.ActivityTimeSeriesByDateRangeByID(userID, startDate, endDate, CaloriesLog)
+	if err != nil {
+		return nil, err
+	}
+	return CalculateEnergyBalance(caloriesIn.CaloriesIn, caloriesOut.Logs)
+}
+
+func (f *Food) EnergyBalanceByDateRange(startDate string, endDate string) ([]*EnergyBalance, error) {
+	return f.EnergyBalanceByDateRangeByID("-", startDate, endDate)
+}
+
+// CalculateEnergyBalance line up calories in and out by date. a date missing
+// on one side counts as 0
+func CalculateEnergyBalance(caloriesIn []*ActivitiesLog, caloriesOut []*ActivitiesLog) ([]*EnergyBalance, error) {
+	balances := map[string]*EnergyBalance{}
+	balanceOf := func(date string) *EnergyBalance {
+		if _, ok := balances[date]; !ok {
+			balances[date] = &EnergyBalance{Date: date}
+		}
+		return balances[date]
+	}
+
+	for _, log := range caloriesIn {
+		value, err := strconv.ParseFloat(log.Value, 64)
+		if err != nil {
+			return nil, err
+		}
+		balanceOf(log.DateTime).CaloriesIn = value
+	}
+	for _, log := range caloriesOut {
+		value, err := strconv.ParseFloat(log.Value, 64)
+		if err != nil {
+			return nil, err
+		}
+		balanceOf(log.DateTime).CaloriesOut = value
+	}
+
+	result := make([]*EnergyBalance, 0, len(balances))
+	for _, balance := range balances {
+		result = append(result, balance)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Date < result[j].Date })
+	return result, nil
+}
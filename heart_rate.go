@@ -0,0 +1,123 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	// HeartRateResource fitbit heart rate resource path
+	HeartRateResource string = "activities/heart"
+)
+
+// HeartRate fitbit heart rate api
+type HeartRate struct {
+	c *Client
+}
+
+// HeartRateValue heart rate summary of a day
+type HeartRateValue struct {
+	CustomHeartRateZones []*HeartRateZone `json:"customHeartRateZones"`
+	HeartRateZones       []*HeartRateZone `json:"heartRateZones"`
+	RestingHeartRate     uint64           `json:"restingHeartRate"`
+}
+
+// HeartRateDay heart rate of a day
+type HeartRateDay struct {
+	DateTime string          `json:"dateTime"`
+	Value    *HeartRateValue `json:"value"`
+}
+
+// HeartRateIntradayDataSet heart rate at Time. Time is zero when date or
+// timezone of dataset is unknown
+type HeartRateIntradayDataSet struct {
+	Time  time.Time `json:"-"`
+	Value uint64    `json:"value"`
+	// RawTime "HH:mm:ss" as returned by api
+	RawTime string `json:"time"`
+}
+
+// HeartRateIntraday intraday heart rate
+type HeartRateIntraday struct {
+	DataSet         []*HeartRateIntradayDataSet `json:"dataset"`
+	DataSetInterval uint64                      `json:"datasetInterval"`
+	DataSetType     string                      `json:"datasetType"`
+}
+
+type HeartRateTimeSeriesResponse struct {
+	HeartRate []*HeartRateDay `json:"activities-heart"`
+}
+
+type HeartRateIntradayResponse struct {
+	HeartRate         []*HeartRateDay    `json:"activities-heart"`
+	HeartRateIntraday *HeartRateIntraday `json:"activities-heart-intraday"`
+}
+
+func (h *HeartRate) getTimeSeries(url string) (*HeartRateTimeSeriesResponse, error) {
+	responseByteArray, err := h.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &HeartRateTimeSeriesResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// TimeSeriesByID heart rate of period ending at date
+func (h *HeartRate) TimeSeriesByID(userID string, date string, period Period) (*HeartRateTimeSeriesResponse, error) {
+	return h.getTimeSeries(fmt.Sprintf(ActivityTimeSeriesURL, userID, HeartRateResource, date, string(period)))
+}
+
+func (h *HeartRate) TimeSeries(date string, period Period) (*HeartRateTimeSeriesResponse, error) {
+	return h.TimeSeriesByID("-", date, period)
+}
+
+// TimeSeriesByDateRangeByID heart rate between startDate and endDate
+func (h *HeartRate) TimeSeriesByDateRangeByID(userID string, startDate string, endDate string) (*HeartRateTimeSeriesResponse, error) {
+	return h.getTimeSeries(fmt.Sprintf(ActivityTimeSeriesURL, userID, HeartRateResource, startDate, endDate))
+}
+
+func (h *HeartRate) TimeSeriesByDateRange(startDate string, endDate string) (*HeartRateTimeSeriesResponse, error) {
+	return h.TimeSeriesByDateRangeByID("-", startDate, endDate)
+}
+
+// IntradayByID intraday heart rate of date. detailLevel is OneSecond or
+// OneMinute. window nil means whole day
+func (h *HeartRate) IntradayByID(userID string, date string, detailLevel DetailLevel, window *TimeWindow) (*HeartRateIntradayResponse, error) {
+	responseByteArray, err := h.c.Get(intradayURL(userID, HeartRateResource, date, detailLevel, window))
+	if err != nil {
+		return nil, err
+	}
+	response := &HeartRateIntradayResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	if response.HeartRateIntraday == nil {
+		return response, nil
+	}
+	// date may be "today". use the date api returned
+	if len(response.HeartRate) > 0 {
+		date = response.HeartRate[0].DateTime
+	}
+	if _, err = time.Parse("2006-01-02", date); err != nil || len(response.HeartRateIntraday.DataSet) == 0 {
+		return response, nil
+	}
+	location, ok := h.c.locationOf(userID)
+	if !ok {
+		return response, nil
+	}
+	for _, dataSet := range response.HeartRateIntraday.DataSet {
+		dataSet.Time, err = time.ParseInLocation("2006-01-02 15:04:05", date+" "+dataSet.RawTime, location)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (h *HeartRate) Intraday(date string, detailLevel DetailLevel, window *TimeWindow) (*HeartRateIntradayResponse, error) {
+	return h.IntradayByID("-", date, detailLevel, window)
+}
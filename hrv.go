@@ -0,0 +1,118 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	HRVURL              string = "https://api.fitbit.com/1/user/%s/hrv/date/%s.json"
+	HRVRangeURL         string = "https://api.fitbit.com/1/user/%s/hrv/date/%s/%s.json"
+	HRVIntradayURL      string = "https://api.fitbit.com/1/user/%s/hrv/date/%s/all.json"
+	HRVIntradayRangeURL string = "https://api.fitbit.com/1/user/%s/hrv/date/%s/%s/all.json"
+)
+
+// HRV fitbit heart rate variability api
+type HRV struct {
+	c *Client
+}
+
+// HRVValue RMSSD of a day
+type HRVValue struct {
+	DailyRmssd float64 `json:"dailyRmssd"`
+	DeepRmssd  float64 `json:"deepRmssd"`
+}
+
+// HRVSummary heart rate variability of a day
+type HRVSummary struct {
+	DateTime string    `json:"dateTime"`
+	Value    *HRVValue `json:"value"`
+}
+
+// HRVMinuteValue heart rate variability of 5 minutes
+type HRVMinuteValue struct {
+	Coverage float64 `json:"coverage"`
+	HF       float64 `json:"hf"`
+	LF       float64 `json:"lf"`
+	Rmssd    float64 `json:"rmssd"`
+}
+
+// HRVMinute heart rate variability at minute
+type HRVMinute struct {
+	Minute string          `json:"minute"`
+	Value  *HRVMinuteValue `json:"value"`
+}
+
+// HRVIntraday intraday heart rate variability of a day
+type HRVIntraday struct {
+	DateTime string       `json:"dateTime"`
+	Minutes  []*HRVMinute `json:"minutes"`
+}
+
+type HRVSummaryResponse struct {
+	HRV []*HRVSummary `json:"hrv"`
+}
+
+type HRVIntradayResponse struct {
+	HRV []*HRVIntraday `json:"hrv"`
+}
+
+func (h *HRV) getSummary(url string) (*HRVSummaryResponse, error) {
+	responseByteArray, err := h.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &HRVSummaryResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (h *HRV) getIntraday(url string) (*HRVIntradayResponse, error) {
+	responseByteArray, err := h.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &HRVIntradayResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// SummaryByID daily RMSSD of date
+func (h *HRV) SummaryByID(userID string, date string) (*HRVSummaryResponse, error) {
+	return h.getSummary(fmt.Sprintf(HRVURL, userID, date))
+}
+
+func (h *HRV) Summary(date string) (*HRVSummaryResponse, error) {
+	return h.SummaryByID("-", date)
+}
+
+// SummaryByDateRangeByID daily RMSSD between startDate and endDate
+func (h *HRV) SummaryByDateRangeByID(userID string, startDate string, endDate string) (*HRVSummaryResponse, error) {
+	return h.getSummary(fmt.Sprintf(HRVRangeURL, userID, startDate, endDate))
+}
+
+func (h *HRV) SummaryByDateRange(startDate string, endDate string) (*HRVSummaryResponse, error) {
+	return h.SummaryByDateRangeByID("-", startDate, endDate)
+}
+
+// IntradayByID intraday heart rate variability of date
+func (h *HRV) IntradayByID(userID string, date string) (*HRVIntradayResponse, error) {
+	return h.getIntraday(fmt.Sprintf(HRVIntradayURL, userID, date))
+}
+
+func (h *HRV) Intraday(date string) (*HRVIntradayResponse, error) {
+	return h.IntradayByID("-", date)
+}
+
+// IntradayByDateRangeByID intraday heart rate variability between startDate and endDate
+func (h *HRV) IntradayByDateRangeByID(userID string, startDate string, endDate string) (*HRVIntradayResponse, error) {
+	return h.getIntraday(fmt.Sprintf(HRVIntradayRangeURL, userID, startDate, endDate))
+}
+
+func (h *HRV) IntradayByDateRange(startDate string, endDate string) (*HRVIntradayResponse, error) {
+	return h.IntradayByDateRangeByID("-", startDate, endDate)
+}
@@ -0,0 +1,133 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	IRNAlertListURL string = "https://api.fitbit.com/1/user/%s/irn/alerts/list.json"
+	IRNProfileURL   string = "https://api.fitbit.com/1/user/%s/irn/profile.json"
+
+	irnAlertListMaxLimit uint64 = 10
+)
+
+// IRN fitbit irregular rhythm notifications api
+type IRN struct {
+	c *Client
+}
+
+// IRNTachogram heart rate intervals of irregular rhythm window
+type IRNTachogram struct {
+	StartTime string    `json:"startTime"`
+	Data      []float64 `json:"data"`
+}
+
+// IRNAlert irregular rhythm notification
+type IRNAlert struct {
+	AlertTime      string          `json:"alertTime"`
+	DetectedTime   string          `json:"detectedTime"`
+	ServiceVersion string          `json:"serviceVersion"`
+	AlgoVersion    uint64          `json:"algoVersion"`
+	Tachograms     []*IRNTachogram `json:"tachograms"`
+}
+
+// IRNProfile user's enrollment to irregular rhythm notifications
+type IRNProfile struct {
+	Onboarded   bool   `json:"onboarded"`
+	Enrolled    bool   `json:"enrolled"`
+	LastUpdated string `json:"lastUpdated"`
+}
+
+type IRNAlertListResponse struct {
+	Alerts     []*IRNAlert `json:"alerts"`
+	Pagination *Pagination `json:"pagination"`
+}
+
+func (i *IRN) getAlertList(url string) (*IRNAlertListResponse, error) {
+	responseByteArray, err := i.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &IRNAlertListResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// AlertListByID one page of irregular rhythm notification list
+func (i *IRN) AlertListByID(userID string, params *ListParams) (*IRNAlertListResponse, error) {
+	query, err := params.query(irnAlertListMaxLimit)
+	if err != nil {
+		return nil, err
+	}
+	return i.getAlertList(fmt.Sprintf(IRNAlertListURL, userID) + "?" + query)
+}
+
+func (i *IRN) AlertList(params *ListParams) (*IRNAlertListResponse, error) {
+	return i.AlertListByID("-", params)
+}
+
+// ProfileByID irregular rhythm notifications profile
+func (i *IRN) ProfileByID(userID string) (*IRNProfile, error) {
+	responseByteArray, err := i.c.Get(fmt.Sprintf(IRNProfileURL, userID))
+	if err != nil {
+		return nil, err
+	}
+	response := &IRNProfile{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (i *IRN) Profile() (*IRNProfile, error) {
+	return i.ProfileByID("-")
+}
+
+// IRNAlertIterator iterate irregular rhythm notification list following pagination
+//
+//	it := client.IRN.AlertIterator(params)
+//	for it.Next() {
+//		alert := it.Alert()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type IRNAlertIterator struct {
+	pages  *pageIterator
+	alerts []*IRNAlert
+}
+
+// AlertIteratorByID iterator of irregular rhythm notification list
+func (i *IRN) AlertIteratorByID(userID string, params *ListParams) *IRNAlertIterator {
+	it := &IRNAlertIterator{}
+	it.pages = newPageIterator(fmt.Sprintf(IRNAlertListURL, userID), params, irnAlertListMaxLimit, func(url string) (int, *Pagination, error) {
+		response, err := i.getAlertList(url)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.alerts = response.Alerts
+		return len(it.alerts), response.Pagination, nil
+	})
+	return it
+}
+
+func (i *IRN) AlertIterator(params *ListParams) *IRNAlertIterator {
+	return i.AlertIteratorByID("-", params)
+}
+
+// Next advance to next alert. fetch next page when needed
+func (it *IRNAlertIterator) Next() bool {
+	return it.pages.advance()
+}
+
+// Alert current alert
+func (it *IRNAlertIterator) Alert() *IRNAlert {
+	return it.alerts[it.pages.index]
+}
+
+// Err error which stopped iteration
+func (it *IRNAlertIterator) Err() error {
+	return it.pages.err
+}
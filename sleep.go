@@ -0,0 +1,298 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type SleepLogType string
+type SleepLevel string
+
+const (
+	SleepStages  SleepLogType = "stages"
+	SleepClassic SleepLogType = "classic"
+
+	// stages levels
+	SleepDeep  SleepLevel = "deep"
+	SleepLight SleepLevel = "light"
+	SleepREM   SleepLevel = "rem"
+	SleepWake  SleepLevel = "wake"
+	// classic levels
+	SleepAsleep   SleepLevel = "asleep"
+	SleepRestless SleepLevel = "restless"
+	SleepAwake    SleepLevel = "awake"
+
+	SleepByDateURL      string = "https://api.fitbit.com/1.2/user/%s/sleep/date/%s.json"
+	SleepByDateRangeURL string = "https://api.fitbit.com/1.2/user/%s/sleep/date/%s/%s.json"
+	SleepLogListURL     string = "https://api.fitbit.com/1.2/user/%s/sleep/list.json"
+	CreateSleepLogURL   string = "https://api.fitbit.com/1.2/user/-/sleep.json"
+	DeleteSleepLogURL   string = "https://api.fitbit.com/1.2/user/-/sleep/%d.json"
+	SleepGoalURL        string = "https://api.fitbit.com/1.2/user/%s/sleep/goal.json"
+
+	sleepLogListMaxLimit uint64 = 100
+)
+
+// Sleep fitbit sleep api
+type Sleep struct {
+	c *Client
+}
+
+// SleepLevelData one period of sleep level
+type SleepLevelData struct {
+	DateTime string     `json:"dateTime"`
+	Level    SleepLevel `json:"level"`
+	Seconds  uint64     `json:"seconds"`
+}
+
+// SleepLevelSummary summary of a sleep level
+type SleepLevelSummary struct {
+	Count               uint64 `json:"count"`
+	Minutes             uint64 `json:"minutes"`
+	ThirtyDayAvgMinutes uint64 `json:"thirtyDayAvgMinutes"`
+}
+
+// SleepLevels levels of sleep log. stages log has deep, light, rem and wake,
+// classic log has asleep, restless and awake
+type SleepLevels struct {
+	Data      []*SleepLevelData                 `json:"data"`
+	ShortData []*SleepLevelData                 `json:"shortData"`
+	Summary   map[SleepLevel]*SleepLevelSummary `json:"summary"`
+}
+
+// SleepLog fitbit sleep log
+type SleepLog struct {
+	DateOfSleep         string       `json:"dateOfSleep"`
+	Duration            uint64       `json:"duration"`
+	Efficiency          uint64       `json:"efficiency"`
+	EndTime             string       `json:"endTime"`
+	InfoCode            uint64       `json:"infoCode"`
+	IsMainSleep         bool         `json:"isMainSleep"`
+	Levels              *SleepLevels `json:"levels"`
+	LogID               uint64       `json:"logId"`
+	LogType             string       `json:"logType"`
+	MinutesAfterWakeup  uint64       `json:"minutesAfterWakeup"`
+	MinutesAsleep       uint64       `json:"minutesAsleep"`
+	MinutesAwake        uint64       `json:"minutesAwake"`
+	MinutesToFallAsleep uint64       `json:"minutesToFallAsleep"`
+	StartTime           string       `json:"startTime"`
+	TimeInBed           uint64       `json:"timeInBed"`
+	Type                SleepLogType `json:"type"`
+}
+
+// SleepStagesSummary total minutes of each stage
+type SleepStagesSummary struct {
+	Deep  uint64 `json:"deep"`
+	Light uint64 `json:"light"`
+	REM   uint64 `json:"rem"`
+	Wake  uint64 `json:"wake"`
+}
+
+// SleepSummary summary of sleep logs
+type SleepSummary struct {
+	Stages             *SleepStagesSummary `json:"stages"`
+	TotalMinutesAsleep uint64              `json:"totalMinutesAsleep"`
+	TotalSleepRecords  uint64              `json:"totalSleepRecords"`
+	TotalTimeInBed     uint64              `json:"totalTimeInBed"`
+}
+
+type SleepResponse struct {
+	Sleep   []*SleepLog   `json:"sleep"`
+	Summary *SleepSummary `json:"summary"`
+}
+
+// MainSleep return main sleep log
+func (r *SleepResponse) MainSleep() (*SleepLog, bool) {
+	for _, sleepLog := range r.Sleep {
+		if sleepLog.IsMainSleep {
+			return sleepLog, true
+		}
+	}
+	return nil, false
+}
+
+type SleepLogListResponse struct {
+	Sleep      []*SleepLog `json:"sleep"`
+	Pagination *Pagination `json:"pagination"`
+}
+
+type CreateSleepLogResponse struct {
+	Sleep *SleepLog `json:"sleep"`
+}
+
+// SleepGoal fitbit sleep goal
+type SleepGoal struct {
+	MinDuration uint64 `json:"minDuration"`
+	UpdatedOn   string `json:"updatedOn"`
+}
+
+// SleepConsistency fitbit sleep consistency
+type SleepConsistency struct {
+	AwakeRestlessPercentage float64 `json:"awakeRestlessPercentage"`
+	FlowID                  uint64  `json:"flowId"`
+	RecommendedSleepGoal    uint64  `json:"recommendedSleepGoal"`
+	TypicalDuration         uint64  `json:"typicalDuration"`
+	TypicalWakeupTime       string  `json:"typicalWakeupTime"`
+}
+
+type SleepGoalResponse struct {
+	Consistency *SleepConsistency `json:"consistency"`
+	Goal        *SleepGoal        `json:"goal"`
+}
+
+func (s *Sleep) getSleep(url string) (*SleepResponse, error) {
+	responseByteArray, err := s.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &SleepResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ByDateByID sleep logs of date
+func (s *Sleep) ByDateByID(userID string, date string) (*SleepResponse, error) {
+	return s.getSleep(fmt.Sprintf(SleepByDateURL, userID, date))
+}
+
+func (s *Sleep) ByDate(date string) (*SleepResponse, error) {
+	return s.ByDateByID("-", date)
+}
+
+// ByDateRangeByID sleep logs between startDate and endDate
+func (s *Sleep) ByDateRangeByID(userID string, startDate string, endDate string) (*SleepResponse, error) {
+	return s.getSleep(fmt.Sprintf(SleepByDateRangeURL, userID, startDate, endDate))
+}
+
+func (s *Sleep) ByDateRange(startDate string, endDate string) (*SleepResponse, error) {
+	return s.ByDateRangeByID("-", startDate, endDate)
+}
+
+func (s *Sleep) getLogList(url string) (*SleepLogListResponse, error) {
+	responseByteArray, err := s.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &SleepLogListResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// LogListByID one page of sleep log list
+func (s *Sleep) LogListByID(userID string, params *ListParams) (*SleepLogListResponse, error) {
+	query, err := params.query(sleepLogListMaxLimit)
+	if err != nil {
+		return nil, err
+	}
+	return s.getLogList(fmt.Sprintf(SleepLogListURL, userID) + "?" + query)
+}
+
+func (s *Sleep) LogList(params *ListParams) (*SleepLogListResponse, error) {
+	return s.LogListByID("-", params)
+}
+
+// SleepLogIterator iterate sleep log list following pagination
+//
+//	it := client.Sleep.LogIterator(params)
+//	for it.Next() {
+//		sleepLog := it.Log()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type SleepLogIterator struct {
+	pages *pageIterator
+	logs  []*SleepLog
+}
+
+// LogIteratorByID iterator of sleep log list
+func (s *Sleep) LogIteratorByID(userID string, params *ListParams) *SleepLogIterator {
+	it := &SleepLogIterator{}
+	it.pages = newPageIterator(fmt.Sprintf(SleepLogListURL, userID), params, sleepLogListMaxLimit, func(url string) (int, *Pagination, error) {
+		response, err := s.getLogList(url)
+		if err != nil {
+			return 0, nil, err
+		}
+		it.logs = response.Sleep
+		return len(it.logs), response.Pagination, nil
+	})
+	return it
+}
+
+func (s *Sleep) LogIterator(params *ListParams) *SleepLogIterator {
+	return s.LogIteratorByID("-", params)
+}
+
+// Next advance to next log. fetch next page when needed
+func (it *SleepLogIterator) Next() bool {
+	return it.pages.advance()
+}
+
+// Log current log
+func (it *SleepLogIterator) Log() *SleepLog {
+	return it.logs[it.pages.index]
+}
+
+// Err error which stopped iteration
+func (it *SleepLogIterator) Err() error {
+	return it.pages.err
+}
+
+// CreateLog log sleep of duration starting at startTime
+func (s *Sleep) CreateLog(startTime time.Time, duration time.Duration) (*CreateSleepLogResponse, error) {
+	values := url.Values{}
+	values.Add("startTime", startTime.Format("15:04"))
+	values.Add("duration", strconv.FormatInt(int64(duration/time.Millisecond), 10))
+	values.Add("date", startTime.Format("2006-01-02"))
+	responseByteArray, err := s.c.PostForm(CreateSleepLogURL, values)
+	if err != nil {
+		return nil, err
+	}
+	response := &CreateSleepLogResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DeleteLog delete sleep log
+func (s *Sleep) DeleteLog(logID uint64) error {
+	return s.c.Delete(fmt.Sprintf(DeleteSleepLogURL, logID))
+}
+
+// GetGoalByID sleep goal
+func (s *Sleep) GetGoalByID(userID string) (*SleepGoalResponse, error) {
+	responseByteArray, err := s.c.Get(fmt.Sprintf(SleepGoalURL, userID))
+	if err != nil {
+		return nil, err
+	}
+	response := &SleepGoalResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *Sleep) GetGoal() (*SleepGoalResponse, error) {
+	return s.GetGoalByID("-")
+}
+
+// UpdateGoal update sleep goal. minDuration is in minutes
+func (s *Sleep) UpdateGoal(minDuration uint64) (*SleepGoalResponse, error) {
+	values := url.Values{}
+	values.Add("minDuration", strconv.FormatUint(minDuration, 10))
+	responseByteArray, err := s.c.PostForm(fmt.Sprintf(SleepGoalURL, "-"), values)
+	if err != nil {
+		return nil, err
+	}
+	response := &SleepGoalResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,198 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const (
+	FriendsURL            string = "https://api.fitbit.com/1.1/user/%s/friends.json"
+	FriendsLeaderboardURL string = "https://api.fitbit.com/1.1/user/%s/leaderboard/friends.json"
+	InvitationsURL        string = "https://api.fitbit.com/1/user/-/friends/invitations.json"
+	InvitationURL         string = "https://api.fitbit.com/1/user/-/friends/invitations/%s.json"
+)
+
+// Social fitbit friends api
+type Social struct {
+	c *Client
+}
+
+// Friend fitbit friend
+type Friend struct {
+	ID     string
+	Name   string
+	Avatar string
+	Child  bool
+	Friend bool
+}
+
+// LeaderboardEntry rank of a user in friends leaderboard
+type LeaderboardEntry struct {
+	User        *Friend
+	StepRank    uint64
+	StepSummary uint64
+}
+
+type personAttributes struct {
+	Avatar string `json:"avatar"`
+	Child  bool   `json:"child"`
+	Friend bool   `json:"friend"`
+	Name   string `json:"name"`
+}
+
+type personData struct {
+	Attributes *personAttributes `json:"attributes"`
+	ID         string            `json:"id"`
+	Type       string            `json:"type"`
+}
+
+func (p *personData) friend() *Friend {
+	friend := &Friend{ID: p.ID}
+	if p.Attributes != nil {
+		friend.Name = p.Attributes.Name
+		friend.Avatar = p.Attributes.Avatar
+		friend.Child = p.Attributes.Child
+		friend.Friend = p.Attributes.Friend
+	}
+	return friend
+}
+
+type friendsResponse struct {
+	Data []*personData `json:"data"`
+}
+
+type rankedUserData struct {
+	Attributes struct {
+		StepRank    uint64 `json:"step-rank"`
+		StepSummary uint64 `json:"step-summary"`
+	} `json:"attributes"`
+	ID            string `json:"id"`
+	Relationships struct {
+		User struct {
+			Data *personData `json:"data"`
+		} `json:"user"`
+	} `json:"relationships"`
+}
+
+type leaderboardResponse struct {
+	Data     []*rankedUserData `json:"data"`
+	Included []*personData     `json:"included"`
+}
+
+// Invitation friend invitation
+type Invitation struct {
+	User *UserProfile `json:"user"`
+}
+
+type InvitationsResponse struct {
+	Friends []*Invitation `json:"friends"`
+}
+
+// GetFriendsByID friends of user
+func (s *Social) GetFriendsByID(userID string) ([]*Friend, error) {
+	responseByteArray, err := s.c.Get(fmt.Sprintf(FriendsURL, userID))
+	if err != nil {
+		return nil, err
+	}
+	response := &friendsResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+
+	friends := make([]*Friend, 0, len(response.Data))
+	for _, person := range response.Data {
+		friends = append(friends, person.friend())
+	}
+	return friends, nil
+}
+
+func (s *Social) GetFriends() ([]*Friend, error) {
+	return s.GetFriendsByID("-")
+}
+
+// GetFriendsLeaderboardByID friends leaderboard in rank order
+func (s *Social) GetFriendsLeaderboardByID(userID string) ([]*LeaderboardEntry, error) {
+	responseByteArray, err := s.c.Get(fmt.Sprintf(FriendsLeaderboardURL, userID))
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalLeaderboard(responseByteArray)
+}
+
+func (s *Social) GetFriendsLeaderboard() ([]*LeaderboardEntry, error) {
+	return s.GetFriendsLeaderboardByID("-")
+}
+
+func unmarshalLeaderboard(responseByteArray []byte) ([]*LeaderboardEntry, error) {
+	response := &leaderboardResponse{}
+	if err := json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+
+	users := map[string]*Friend{}
+	for _, person := range response.Included {
+		users[person.ID] = person.friend()
+	}
+	entries := make([]*LeaderboardEntry, 0, len(response.Data))
+	for _, rankedUser := range response.Data {
+		entry := &LeaderboardEntry{
+			StepRank:    rankedUser.Attributes.StepRank,
+			StepSummary: rankedUser.Attributes.StepSummary,
+		}
+		if person := rankedUser.Relationships.User.Data; person != nil {
+			entry.User = users[person.ID]
+			if entry.User == nil {
+				entry.User = &Friend{ID: person.ID}
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// GetInvitations friend invitations received
+func (s *Social) GetInvitations() (*InvitationsResponse, error) {
+	responseByteArray, err := s.c.Get(InvitationsURL)
+	if err != nil {
+		return nil, err
+	}
+	response := &InvitationsResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// InviteFriend send friend invitation. set either email or userID
+func (s *Social) InviteFriend(email string, userID string) error {
+	values := url.Values{}
+	switch {
+	case email != "":
+		values.Add("invitedUserEmail", email)
+	case userID != "":
+		values.Add("invitedUserId", userID)
+	default:
+		return errors.New("email or userID is required")
+	}
+	_, err := s.c.PostForm(InvitationsURL, values)
+	return err
+}
+
+// RespondInvitation accept or reject invitation from userID
+func (s *Social) RespondInvitation(userID string, accept bool) error {
+	values := url.Values{}
+	values.Add("accept", strconv.FormatBool(accept))
+	_, err := s.c.PostForm(fmt.Sprintf(InvitationURL, userID), values)
+	return err
+}
+
+func (s *Social) AcceptInvitation(userID string) error {
+	return s.RespondInvitation(userID, true)
+}
+
+func (s *Social) RejectInvitation(userID string) error {
+	return s.RespondInvitation(userID, false)
+}
@@ -0,0 +1,111 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	SpO2URL              string = "https://api.fitbit.com/1/user/%s/spo2/date/%s.json"
+	SpO2RangeURL         string = "https://api.fitbit.com/1/user/%s/spo2/date/%s/%s.json"
+	SpO2IntradayURL      string = "https://api.fitbit.com/1/user/%s/spo2/date/%s/all.json"
+	SpO2IntradayRangeURL string = "https://api.fitbit.com/1/user/%s/spo2/date/%s/%s/all.json"
+)
+
+// SpO2 fitbit SpO2 api
+type SpO2 struct {
+	c *Client
+}
+
+// SpO2Value SpO2 percentage of a night
+type SpO2Value struct {
+	Avg float64 `json:"avg"`
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// SpO2Summary SpO2 summary of a day
+type SpO2Summary struct {
+	DateTime string     `json:"dateTime"`
+	Value    *SpO2Value `json:"value"`
+}
+
+// SpO2Minute SpO2 percentage at minute
+type SpO2Minute struct {
+	Minute string  `json:"minute"`
+	Value  float64 `json:"value"`
+}
+
+// SpO2Intraday intraday SpO2 of a day
+type SpO2Intraday struct {
+	DateTime string        `json:"dateTime"`
+	Minutes  []*SpO2Minute `json:"minutes"`
+}
+
+// SummaryByID SpO2 summary of date
+func (s *SpO2) SummaryByID(userID string, date string) (*SpO2Summary, error) {
+	responseByteArray, err := s.c.Get(fmt.Sprintf(SpO2URL, userID, date))
+	if err != nil {
+		return nil, err
+	}
+	response := &SpO2Summary{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *SpO2) Summary(date string) (*SpO2Summary, error) {
+	return s.SummaryByID("-", date)
+}
+
+// SummaryByDateRangeByID SpO2 summaries between startDate and endDate
+func (s *SpO2) SummaryByDateRangeByID(userID string, startDate string, endDate string) ([]*SpO2Summary, error) {
+	responseByteArray, err := s.c.Get(fmt.Sprintf(SpO2RangeURL, userID, startDate, endDate))
+	if err != nil {
+		return nil, err
+	}
+	var response []*SpO2Summary
+	if err = json.Unmarshal(responseByteArray, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *SpO2) SummaryByDateRange(startDate string, endDate string) ([]*SpO2Summary, error) {
+	return s.SummaryByDateRangeByID("-", startDate, endDate)
+}
+
+// IntradayByID intraday SpO2 of date
+func (s *SpO2) IntradayByID(userID string, date string) (*SpO2Intraday, error) {
+	responseByteArray, err := s.c.Get(fmt.Sprintf(SpO2IntradayURL, userID, date))
+	if err != nil {
+		return nil, err
+	}
+	response := &SpO2Intraday{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *SpO2) Intraday(date string) (*SpO2Intraday, error) {
+	return s.IntradayByID("-", date)
+}
+
+// IntradayByDateRangeByID intraday SpO2 between startDate and endDate
+func (s *SpO2) IntradayByDateRangeByID(userID string, startDate string, endDate string) ([]*SpO2Intraday, error) {
+	responseByteArray, err := s.c.Get(fmt.Sprintf(SpO2IntradayRangeURL, userID, startDate, endDate))
+	if err != nil {
+		return nil, err
+	}
+	var response []*SpO2Intraday
+	if err = json.Unmarshal(responseByteArray, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *SpO2) IntradayByDateRange(startDate string, endDate string) ([]*SpO2Intraday, error) {
+	return s.IntradayByDateRangeByID("-", startDate, endDate)
+}
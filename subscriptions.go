@@ -0,0 +1,95 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	SubscriptionURL  string = "https://api.fitbit.com/1/user/-/%sapiSubscriptions/%s.json"
+	SubscriptionsURL string = "https://api.fitbit.com/1/user/-/%sapiSubscriptions.json"
+)
+
+// Subscriptions fitbit subscriptions api
+type Subscriptions struct {
+	c *Client
+}
+
+// Subscription fitbit subscription
+type Subscription struct {
+	CollectionType CollectionType `json:"collectionType"`
+	OwnerID        string         `json:"ownerId"`
+	OwnerType      string         `json:"ownerType"`
+	SubscriberID   string         `json:"subscriberId"`
+	SubscriptionID string         `json:"subscriptionId"`
+}
+
+type SubscriptionsResponse struct {
+	APISubscriptions []*Subscription `json:"apiSubscriptions"`
+}
+
+func collectionPath(collectionType CollectionType) string {
+	if collectionType == AllCollections {
+		return ""
+	}
+	return string(collectionType) + "/"
+}
+
+func (s *Subscriptions) request(method string, url string, subscriberID string) ([]byte, error) {
+	request, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if subscriberID != "" {
+		request.Header.Set("X-Fitbit-Subscriber-Id", subscriberID)
+	}
+
+	result, err := s.c.do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Body.Close()
+
+	responseByteArray, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+	if result.StatusCode != 200 && result.StatusCode != 201 && result.StatusCode != 204 {
+		return nil, newErrorResponse(result.StatusCode, responseByteArray)
+	}
+	return responseByteArray, nil
+}
+
+// Create subscribe to collectionType. subscriberID empty means default subscriber
+func (s *Subscriptions) Create(collectionType CollectionType, subscriptionID string, subscriberID string) (*Subscription, error) {
+	responseByteArray, err := s.request("POST", fmt.Sprintf(SubscriptionURL, collectionPath(collectionType), subscriptionID), subscriberID)
+	if err != nil {
+		return nil, err
+	}
+	response := &Subscription{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// List subscriptions of collectionType. AllCollections lists every subscription
+func (s *Subscriptions) List(collectionType CollectionType) (*SubscriptionsResponse, error) {
+	responseByteArray, err := s.c.Get(fmt.Sprintf(SubscriptionsURL, collectionPath(collectionType)))
+	if err != nil {
+		return nil, err
+	}
+	response := &SubscriptionsResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Delete unsubscribe. subscriberID empty means default subscriber
+func (s *Subscriptions) Delete(collectionType CollectionType, subscriptionID string, subscriberID string) error {
+	_, err := s.request("DELETE", fmt.Sprintf(SubscriptionURL, collectionPath(collectionType), subscriptionID), subscriberID)
+	return err
+}
@@ -0,0 +1,90 @@
+package fitbit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// TCXPosition trackpoint position
+type TCXPosition struct {
+	LatitudeDegrees  float64 `xml:"LatitudeDegrees"`
+	LongitudeDegrees float64 `xml:"LongitudeDegrees"`
+}
+
+// TCXTrackpoint one point of the recorded track
+type TCXTrackpoint struct {
+	Time           time.Time    `xml:"Time"`
+	Position       *TCXPosition `xml:"Position"`
+	AltitudeMeters float64      `xml:"AltitudeMeters"`
+	DistanceMeters float64      `xml:"DistanceMeters"`
+	HeartRateBpm   uint64       `xml:"HeartRateBpm>Value"`
+}
+
+// TCXLap lap of TCX activity
+type TCXLap struct {
+	StartTime        time.Time        `xml:"StartTime,attr"`
+	TotalTimeSeconds float64          `xml:"TotalTimeSeconds"`
+	DistanceMeters   float64          `xml:"DistanceMeters"`
+	Calories         uint64           `xml:"Calories"`
+	Intensity        string           `xml:"Intensity"`
+	TriggerMethod    string           `xml:"TriggerMethod"`
+	Trackpoints      []*TCXTrackpoint `xml:"Track>Trackpoint"`
+}
+
+// TCXActivity activity of TCX
+type TCXActivity struct {
+	Sport string    `xml:"Sport,attr"`
+	ID    string    `xml:"Id"`
+	Laps  []*TCXLap `xml:"Lap"`
+}
+
+// TCX parsed TrainingCenterDatabase
+type TCX struct {
+	XMLName    xml.Name       `xml:"TrainingCenterDatabase"`
+	Activities []*TCXActivity `xml:"Activities>Activity"`
+}
+
+// Trackpoints return all trackpoints of all laps in order
+func (t *TCX) Trackpoints() []*TCXTrackpoint {
+	var trackpoints []*TCXTrackpoint
+	for _, activity := range t.Activities {
+		for _, lap := range activity.Laps {
+			trackpoints = append(trackpoints, lap.Trackpoints...)
+		}
+	}
+	return trackpoints
+}
+
+// ParseTCX parse TCX bytes
+func ParseTCX(tcxByteArray []byte) (*TCX, error) {
+	tcx := &TCX{}
+	if err := xml.Unmarshal(tcxByteArray, tcx); err != nil {
+		return nil, err
+	}
+	return tcx, nil
+}
+
+// GetActivityTCXRawByID return raw TCX bytes of activity log
+func (a *Activity) GetActivityTCXRawByID(userID string, logID uint64) ([]byte, error) {
+	return a.c.Get(fmt.Sprintf(ActivityTCXURL, userID, logID))
+}
+
+// GetActivityTCXRaw return raw TCX bytes of activity log
+func (a *Activity) GetActivityTCXRaw(logID uint64) ([]byte, error) {
+	return a.GetActivityTCXRawByID("-", logID)
+}
+
+// GetActivityTCXByID download TCX of activity log and parse
+func (a *Activity) GetActivityTCXByID(userID string, logID uint64) (*TCX, error) {
+	responseByteArray, err := a.GetActivityTCXRawByID(userID, logID)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTCX(responseByteArray)
+}
+
+// GetActivityTCX download TCX of activity log and parse
+func (a *Activity) GetActivityTCX(logID uint64) (*TCX, error) {
+	return a.GetActivityTCXByID("-", logID)
+}
@@ -0,0 +1,109 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type TemperatureLogType string
+
+const (
+	DedicatedTempSensor TemperatureLogType = "dedicated_temp_sensor"
+	OtherSensors        TemperatureLogType = "other_sensors"
+
+	SkinTemperatureURL      string = "https://api.fitbit.com/1/user/%s/temp/skin/date/%s.json"
+	SkinTemperatureRangeURL string = "https://api.fitbit.com/1/user/%s/temp/skin/date/%s/%s.json"
+	CoreTemperatureURL      string = "https://api.fitbit.com/1/user/%s/temp/core/date/%s.json"
+	CoreTemperatureRangeURL string = "https://api.fitbit.com/1/user/%s/temp/core/date/%s/%s.json"
+)
+
+// Temperature fitbit temperature api
+type Temperature struct {
+	c *Client
+}
+
+// SkinTemperatureValue variation from the user's baseline
+type SkinTemperatureValue struct {
+	NightlyRelative float64 `json:"nightlyRelative"`
+}
+
+// SkinTemperature skin temperature of a night
+type SkinTemperature struct {
+	DateTime string                `json:"dateTime"`
+	LogType  TemperatureLogType    `json:"logType"`
+	Value    *SkinTemperatureValue `json:"value"`
+}
+
+// CoreTemperature manually logged core temperature
+type CoreTemperature struct {
+	DateTime string  `json:"dateTime"`
+	Value    float64 `json:"value"`
+}
+
+type SkinTemperatureResponse struct {
+	TempSkin []*SkinTemperature `json:"tempSkin"`
+}
+
+type CoreTemperatureResponse struct {
+	TempCore []*CoreTemperature `json:"tempCore"`
+}
+
+func (t *Temperature) getSkin(url string) (*SkinTemperatureResponse, error) {
+	responseByteArray, err := t.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &SkinTemperatureResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (t *Temperature) getCore(url string) (*CoreTemperatureResponse, error) {
+	responseByteArray, err := t.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &CoreTemperatureResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// SkinByID skin temperature of date
+func (t *Temperature) SkinByID(userID string, date string) (*SkinTemperatureResponse, error) {
+	return t.getSkin(fmt.Sprintf(SkinTemperatureURL, userID, date))
+}
+
+func (t *Temperature) Skin(date string) (*SkinTemperatureResponse, error) {
+	return t.SkinByID("-", date)
+}
+
+// SkinByDateRangeByID skin temperature between startDate and endDate
+func (t *Temperature) SkinByDateRangeByID(userID string, startDate string, endDate string) (*SkinTemperatureResponse, error) {
+	return t.getSkin(fmt.Sprintf(SkinTemperatureRangeURL, userID, startDate, endDate))
+}
+
+func (t *Temperature) SkinByDateRange(startDate string, endDate string) (*SkinTemperatureResponse, error) {
+	return t.SkinByDateRangeByID("-", startDate, endDate)
+}
+
+// CoreByID core temperature of date
+func (t *Temperature) CoreByID(userID string, date string) (*CoreTemperatureResponse, error) {
+	return t.getCore(fmt.Sprintf(CoreTemperatureURL, userID, date))
+}
+
+func (t *Temperature) Core(date string) (*CoreTemperatureResponse, error) {
+	return t.CoreByID("-", date)
+}
+
+// CoreByDateRangeByID core temperature between startDate and endDate
+func (t *Temperature) CoreByDateRangeByID(userID string, startDate string, endDate string) (*CoreTemperatureResponse, error) {
+	return t.getCore(fmt.Sprintf(CoreTemperatureRangeURL, userID, startDate, endDate))
+}
+
+func (t *Temperature) CoreByDateRange(startDate string, endDate string) (*CoreTemperatureResponse, error) {
+	return t.CoreByDateRangeByID("-", startDate, endDate)
+}
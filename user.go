@@ -0,0 +1,256 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type BadgeType string
+
+const (
+	DailyStepsBadge       BadgeType = "DAILY_STEPS"
+	DailyFloorsBadge      BadgeType = "DAILY_FLOORS"
+	LifetimeDistanceBadge BadgeType = "LIFETIME_DISTANCE"
+	LifetimeFloorsBadge   BadgeType = "LIFETIME_FLOORS"
+
+	ProfileURL   string = "https://api.fitbit.com/1/user/%s/profile.json"
+	GetBadgesURL string = "https://api.fitbit.com/1/user/%s/badges.json"
+)
+
+// User fitbit user api
+type User struct {
+	c *Client
+}
+
+// UserProfile fitbit user profile
+type UserProfile struct {
+	AboutMe                string  `json:"aboutMe"`
+	Age                    uint64  `json:"age"`
+	Avatar                 string  `json:"avatar"`
+	Avatar150              string  `json:"avatar150"`
+	AverageDailySteps      uint64  `json:"averageDailySteps"`
+	City                   string  `json:"city"`
+	ClockTimeDisplayFormat string  `json:"clockTimeDisplayFormat"`
+	Country                string  `json:"country"`
+	DateOfBirth            string  `json:"dateOfBirth"`
+	DisplayName            string  `json:"displayName"`
+	DistanceUnit           string  `json:"distanceUnit"`
+	EncodedID              string  `json:"encodedId"`
+	FirstName              string  `json:"firstName"`
+	FullName               string  `json:"fullName"`
+	Gender                 string  `json:"gender"`
+	GlucoseUnit            string  `json:"glucoseUnit"`
+	Height                 float64 `json:"height"`
+	HeightUnit             string  `json:"heightUnit"`
+	LastName               string  `json:"lastName"`
+	Locale                 string  `json:"locale"`
+	MemberSince            string  `json:"memberSince"`
+	OffsetFromUTCMillis    int64   `json:"offsetFromUTCMillis"`
+	StartDayOfWeek         string  `json:"startDayOfWeek"`
+	StrideLengthRunning    float64 `json:"strideLengthRunning"`
+	StrideLengthWalking    float64 `json:"strideLengthWalking"`
+	Timezone               string  `json:"timezone"`
+	WaterUnit              string  `json:"waterUnit"`
+	Weight                 float64 `json:"weight"`
+	WeightUnit             string  `json:"weightUnit"`
+}
+
+// Location user's timezone. fixed offset is used when timezone can't be loaded
+func (p *UserProfile) Location() *time.Location {
+	if p.Timezone != "" {
+		if location, err := time.LoadLocation(p.Timezone); err == nil {
+			return location
+		}
+	}
+	return time.FixedZone(p.Timezone, int(p.OffsetFromUTCMillis/1000))
+}
+
+// Badge fitbit badge
+type Badge struct {
+	BadgeGradientEndColor   string    `json:"badgeGradientEndColor"`
+	BadgeGradientStartColor string    `json:"badgeGradientStartColor"`
+	BadgeType               BadgeType `json:"badgeType"`
+	Category                string    `json:"category"`
+	DateTime                string    `json:"dateTime"`
+	Description             string    `json:"description"`
+	EarnedMessage           string    `json:"earnedMessage"`
+	EncodedID               string    `json:"encodedId"`
+	Image50px               string    `json:"image50px"`
+	Image75px               string    `json:"image75px"`
+	Image100px              string    `json:"image100px"`
+	Image125px              string    `json:"image125px"`
+	Image300px              string    `json:"image300px"`
+	MarketingDescription    string    `json:"marketingDescription"`
+	MobileDescription       string    `json:"mobileDescription"`
+	Name                    string    `json:"name"`
+	ShareImage640px         string    `json:"shareImage640px"`
+	ShareText               string    `json:"shareText"`
+	ShortDescription        string    `json:"shortDescription"`
+	ShortName               string    `json:"shortName"`
+	TimesAchieved           uint64    `json:"timesAchieved"`
+	Unit                    string    `json:"unit"`
+	Value                   float64   `json:"value"`
+}
+
+type badgesResponse struct {
+	Badges []Badge `json:"badges"`
+}
+
+type UserProfileResponse struct {
+	User *UserProfile `json:"user"`
+}
+
+// UpdateProfileParams parameters of UpdateProfile. empty or nil field isn't sent
+type UpdateProfileParams struct {
+	AboutMe                string
+	Birthday               string
+	City                   string
+	ClockTimeDisplayFormat string
+	Country                string
+	FoodsLocale            string
+	FullName               string
+	Gender                 string
+	GlucoseUnit            string
+	Height                 *float64
+	HeightUnit             string
+	Locale                 string
+	StartDayOfWeek         string
+	StrideLengthRunning    *float64
+	StrideLengthWalking    *float64
+	Timezone               string
+	WaterUnit              string
+	WeightUnit             string
+}
+
+func (p *UpdateProfileParams) values() url.Values {
+	values := url.Values{}
+	strs := []struct {
+		key   string
+		value string
+	}{
+		{"aboutMe", p.AboutMe},
+		{"birthday", p.Birthday},
+		{"city", p.City},
+		{"clockTimeDisplayFormat", p.ClockTimeDisplayFormat},
+		{"country", p.Country},
+		{"foodsLocale", p.FoodsLocale},
+		{"fullname", p.FullName},
+		{"gender", p.Gender},
+		{"glucoseUnit", p.GlucoseUnit},
+		{"heightUnit", p.HeightUnit},
+		{"locale", p.Locale},
+		{"startDayOfWeek", p.StartDayOfWeek},
+		{"timezone", p.Timezone},
+		{"waterUnit", p.WaterUnit},
+		{"weightUnit", p.WeightUnit},
+	}
+	for _, str := range strs {
+		if str.value != "" {
+			values.Add(str.key, str.value)
+		}
+	}
+	floats := []struct {
+		key   string
+		value *float64
+	}{
+		{"height", p.Height},
+		{"strideLengthRunning", p.StrideLengthRunning},
+		{"strideLengthWalking", p.StrideLengthWalking},
+	}
+	for _, number := range floats {
+		if number.value != nil {
+			values.Add(number.key, strconv.FormatFloat(*number.value, 'f', -1, 64))
+		}
+	}
+	return values
+}
+
+// GetProfileByID user profile
+func (u *User) GetProfileByID(userID string) (*UserProfileResponse, error) {
+	responseByteArray, err := u.c.Get(fmt.Sprintf(ProfileURL, userID))
+	if err != nil {
+		return nil, err
+	}
+	response := &UserProfileResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (u *User) GetProfile() (*UserProfileResponse, error) {
+	return u.GetProfileByID("-")
+}
+
+// UpdateProfile update profile and cache the updated profile
+func (u *User) UpdateProfile(params *UpdateProfileParams) (*UserProfileResponse, error) {
+	responseByteArray, err := u.c.PostForm(fmt.Sprintf(ProfileURL, "-"), params.values())
+	if err != nil {
+		return nil, err
+	}
+	response := &UserProfileResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	if response.User != nil {
+		u.c.setProfile(response.User)
+	}
+	return response, nil
+}
+
+func (u *User) GetBadgesByID(userID string) ([]Badge, error) {
+	responseByteArray, err := u.c.Get(fmt.Sprintf(GetBadgesURL, userID))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &badgesResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+
+	return response.Badges, nil
+}
+
+func (u *User) GetBadges() ([]Badge, error) {
+	return u.GetBadgesByID("-")
+}
+
+// LoadProfile fetch profile of authorized user and cache it in Client
+func (c *Client) LoadProfile() (*UserProfile, error) {
+	response, err := c.User.GetProfile()
+	if err != nil {
+		return nil, err
+	}
+	if response.User == nil {
+		return nil, errors.New("profile isn't returned")
+	}
+	c.setProfile(response.User)
+	return response.User, nil
+}
+
+// Profile cached profile. nil before LoadProfile
+func (c *Client) Profile() *UserProfile {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.profile
+}
+
+func (c *Client) setProfile(profile *UserProfile) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.profile = profile
+}
+
+// Today today's date of the user in "yyyy-MM-dd". profile is loaded if not cached
+func (c *Client) Today() (string, error) {
+	location, err := c.location()
+	if err != nil {
+		return "", err
+	}
+	return time.Now().In(location).Format("2006-01-02"), nil
+}
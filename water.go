@@ -0,0 +1,220 @@
+package fitbit
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type WaterUnit string
+
+const (
+	Milliliter WaterUnit = "ml"
+	FluidOunce WaterUnit = "fl oz"
+	Cup        WaterUnit = "cup"
+
+	// WaterResource fitbit water resource path
+	WaterResource string = "foods/log/water"
+
+	WaterLogURL       string = "https://api.fitbit.com/1/user/%s/foods/log/water/date/%s.json"
+	CreateWaterLogURL string = "https://api.fitbit.com/1/user/-/foods/log/water.json"
+	WaterLogEntryURL  string = "https://api.fitbit.com/1/user/-/foods/log/water/%d.json"
+	WaterGoalURL      string = "https://api.fitbit.com/1/user/%s/foods/log/water/goal.json"
+)
+
+// WaterUnit unit of water volume returned in the unit system
+func (u UnitSystem) WaterUnit() WaterUnit {
+	if u == UnitSystemUS {
+		return FluidOunce
+	}
+	return Milliliter
+}
+
+// Water fitbit water api. volumes are returned in the unit system set by
+// Client.SetUnitSystem
+type Water struct {
+	c *Client
+}
+
+// WaterVolume volume of water
+type WaterVolume struct {
+	Amount float64
+	// Unit empty means the unit of client's unit system
+	Unit WaterUnit
+}
+
+// WaterLog fitbit water log
+type WaterLog struct {
+	Amount float64   `json:"amount"`
+	LogID  uint64    `json:"logId"`
+	Unit   WaterUnit `json:"-"`
+}
+
+// WaterLogSummary total water of a day
+type WaterLogSummary struct {
+	Water float64   `json:"water"`
+	Unit  WaterUnit `json:"-"`
+}
+
+type WaterLogResponse struct {
+	Summary *WaterLogSummary `json:"summary"`
+	Water   []*WaterLog      `json:"water"`
+}
+
+type WaterLogEntryResponse struct {
+	WaterLog *WaterLog `json:"waterLog"`
+}
+
+type WaterTimeSeriesResponse struct {
+	Water []*ActivitiesLog `json:"foods-log-water"`
+	Unit  WaterUnit        `json:"-"`
+}
+
+// WaterGoal fitbit water goal
+type WaterGoal struct {
+	Goal      float64   `json:"goal"`
+	StartDate string    `json:"startDate"`
+	Unit      WaterUnit `json:"-"`
+}
+
+type WaterGoalResponse struct {
+	Goal *WaterGoal `json:"goal"`
+}
+
+func (w *Water) unit() WaterUnit {
+	return w.c.unitSystem.WaterUnit()
+}
+
+func (w *Water) volumeValues(volume WaterVolume) url.Values {
+	unit := volume.Unit
+	if unit == "" {
+		unit = w.unit()
+	}
+	values := url.Values{}
+	values.Add("amount", strconv.FormatFloat(volume.Amount, 'f', -1, 64))
+	values.Add("unit", string(unit))
+	return values
+}
+
+// WaterLogByID water logs of date
+func (w *Water) WaterLogByID(userID string, date string) (*WaterLogResponse, error) {
+	responseByteArray, err := w.c.Get(fmt.Sprintf(WaterLogURL, userID, date))
+	if err != nil {
+		return nil, err
+	}
+	response := &WaterLogResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	if response.Summary != nil {
+		response.Summary.Unit = w.unit()
+	}
+	for _, waterLog := range response.Water {
+		waterLog.Unit = w.unit()
+	}
+	return response, nil
+}
+
+func (w *Water) WaterLog(date string) (*WaterLogResponse, error) {
+	return w.WaterLogByID("-", date)
+}
+
+func (w *Water) postWaterLog(url string, values url.Values) (*WaterLogEntryResponse, error) {
+	responseByteArray, err := w.c.PostForm(url, values)
+	if err != nil {
+		return nil, err
+	}
+	response := &WaterLogEntryResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	// amount is returned in the unit system, not in the unit sent
+	if response.WaterLog != nil {
+		response.WaterLog.Unit = w.unit()
+	}
+	return response, nil
+}
+
+// CreateWaterLog log water of date
+func (w *Water) CreateWaterLog(volume WaterVolume, date string) (*WaterLogEntryResponse, error) {
+	values := w.volumeValues(volume)
+	values.Add("date", date)
+	return w.postWaterLog(CreateWaterLogURL, values)
+}
+
+// UpdateWaterLog update volume of water log
+func (w *Water) UpdateWaterLog(logID uint64, volume WaterVolume) (*WaterLogEntryResponse, error) {
+	return w.postWaterLog(fmt.Sprintf(WaterLogEntryURL, logID), w.volumeValues(volume))
+}
+
+// DeleteWaterLog delete water log
+func (w *Water) DeleteWaterLog(logID uint64) error {
+	return w.c.Delete(fmt.Sprintf(WaterLogEntryURL, logID))
+}
+
+func (w *Water) getTimeSeries(url string) (*WaterTimeSeriesResponse, error) {
+	responseByteArray, err := w.c.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	response := &WaterTimeSeriesResponse{}
+	if err = json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	response.Unit = w.unit()
+	return response, nil
+}
+
+// TimeSeriesByID water of period ending at date
+func (w *Water) TimeSeriesByID(userID string, date string, period Period) (*WaterTimeSeriesResponse, error) {
+	return w.getTimeSeries(fmt.Sprintf(ActivityTimeSeriesURL, userID, WaterResource, date, string(period)))
+}
+
+func (w *Water) TimeSeries(date string, period Period) (*WaterTimeSeriesResponse, error) {
+	return w.TimeSeriesByID("-", date, period)
+}
+
+// TimeSeriesByDateRangeByID water between startDate and endDate
+func (w *Water) TimeSeriesByDateRangeByID(userID string, startDate string, endDate string) (*WaterTimeSeriesResponse, error) {
+	return w.getTimeSeries(fmt.Sprintf(ActivityTimeSeriesURL, userID, WaterResource, startDate, endDate))
+}
+
+func (w *Water) TimeSeriesByDateRange(startDate string, endDate string) (*WaterTimeSeriesResponse, error) {
+	return w.TimeSeriesByDateRangeByID("-", startDate, endDate)
+}
+
+func (w *Water) unmarshalGoal(responseByteArray []byte) (*WaterGoalResponse, error) {
+	response := &WaterGoalResponse{}
+	if err := json.Unmarshal(responseByteArray, response); err != nil {
+		return nil, err
+	}
+	if response.Goal != nil {
+		response.Goal.Unit = w.unit()
+	}
+	return response, nil
+}
+
+// GetGoalByID water goal
+func (w *Water) GetGoalByID(userID string) (*WaterGoalResponse, error) {
+	responseByteArray, err := w.c.Get(fmt.Sprintf(WaterGoalURL, userID))
+	if err != nil {
+		return nil, err
+	}
+	return w.unmarshalGoal(responseByteArray)
+}
+
+func (w *Water) GetGoal() (*WaterGoalResponse, error) {
+	return w.GetGoalByID("-")
+}
+
+// UpdateGoal update water goal. target is in the unit of client's unit system
+func (w *Water) UpdateGoal(target float64) (*WaterGoalResponse, error) {
+	values := url.Values{}
+	values.Add("target", strconv.FormatFloat(target, 'f', -1, 64))
+	responseByteArray, err := w.c.PostForm(fmt.Sprintf(WaterGoalURL, "-"), values)
+	if err != nil {
+		return nil, err
+	}
+	return w.unmarshalGoal(responseByteArray)
+}
@@ -0,0 +1,150 @@
+package fitbit
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// maxNotificationBodySize limit of notification body. fitbit sends small json arrays
+const maxNotificationBodySize int64 = 64 << 10
+
+// Notification fitbit subscription notification
+type Notification struct {
+	CollectionType CollectionType `json:"collectionType"`
+	Date           string         `json:"date"`
+	OwnerID        string         `json:"ownerId"`
+	OwnerType      string         `json:"ownerType"`
+	SubscriptionID string         `json:"subscriptionId"`
+}
+
+// NotificationCallback called for each notification
+type NotificationCallback func(notification *Notification)
+
+// WebhookHandler http.Handler of subscriber endpoint. answers verification
+// GET, verifies X-Fitbit-Signature of POST and dispatches notifications to
+// callbacks in background after responding 204. panic of a callback is
+// recovered, reported to PanicHandler and doesn't stop other callbacks
+type WebhookHandler struct {
+	// PanicHandler called with value recovered from panic of a callback.
+	// logged with log.Printf when nil
+	PanicHandler func(notification *Notification, recovered interface{})
+
+	verificationCode string
+	signingKey       []byte
+	mutex            sync.RWMutex
+	callbacks        map[CollectionType][]NotificationCallback
+}
+
+// NewWebhookHandler create handler. signature is verified with client secret
+// of config. verificationCode is the code shown in subscriber settings
+func NewWebhookHandler(config *oauth2.Config, verificationCode string) (*WebhookHandler, error) {
+	if verificationCode == "" {
+		return nil, errors.New("verificationCode is required")
+	}
+	return &WebhookHandler{
+		verificationCode: verificationCode,
+		signingKey:       []byte(config.ClientSecret + "&"),
+		callbacks:        map[CollectionType][]NotificationCallback{},
+	}, nil
+}
+
+// Handle register callback for collectionType. AllCollections receives every notification
+func (h *WebhookHandler) Handle(collectionType CollectionType, callback NotificationCallback) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.callbacks[collectionType] = append(h.callbacks[collectionType], callback)
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		h.serveVerification(w, r)
+	case "POST":
+		h.serveNotification(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *WebhookHandler) serveVerification(w http.ResponseWriter, r *http.Request) {
+	code := r.URL.Query().Get("verify")
+	if code == "" || code != h.verificationCode {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WebhookHandler) serveNotification(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxNotificationBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	// fitbit expects 404 for notifications with invalid signature
+	if !h.VerifySignature(body, r.Header.Get("X-Fitbit-Signature")) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	var notifications []*Notification
+	if err = json.Unmarshal(body, &notifications); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	go h.dispatch(notifications)
+}
+
+// VerifySignature verify X-Fitbit-Signature of body
+func (h *WebhookHandler) VerifySignature(body []byte, signature string) bool {
+	expected, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha1.New, h.signingKey)
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func (h *WebhookHandler) dispatch(notifications []*Notification) {
+	for _, notification := range notifications {
+		for _, callback := range h.callbacksOf(notification.CollectionType) {
+			h.callSafely(callback, notification)
+		}
+	}
+}
+
+// callbacksOf copy of callbacks for collectionType and AllCollections. callbacks
+// are called without lock so that they can register other callbacks
+func (h *WebhookHandler) callbacksOf(collectionType CollectionType) []NotificationCallback {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	callbacks := make([]NotificationCallback, 0, len(h.callbacks[collectionType])+len(h.callbacks[AllCollections]))
+	callbacks = append(callbacks, h.callbacks[collectionType]...)
+	return append(callbacks, h.callbacks[AllCollections]...)
+}
+
+func (h *WebhookHandler) callSafely(callback NotificationCallback, notification *Notification) {
+	defer func() {
+		recovered := recover()
+		if recovered == nil {
+			return
+		}
+		if h.PanicHandler != nil {
+			h.PanicHandler(notification, recovered)
+			return
+		}
+		log.Printf("fitbit: notification callback panicked. notification:%+v panic:%v", notification, recovered)
+	}()
+	callback(notification)
+}